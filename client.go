@@ -0,0 +1,415 @@
+package veo
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"time"
+)
+
+// DefaultBaseURL is the base URL of the Veo app API
+const DefaultBaseURL = "https://app.veo.co/api/app"
+
+// Client represents a Veo API client
+type Client struct {
+	baseURL    string
+	httpClient *http.Client
+	authToken  string
+	csrfToken  string // Initial CSRF token, seeded into the cookie jar
+
+	retryPolicy RetryPolicy
+	limiter     *rateLimiter // nil means unlimited
+}
+
+// ClientOption is a function that configures a Client
+type ClientOption func(*Client)
+
+// WithAuthToken sets the authentication token
+func WithAuthToken(token string) ClientOption {
+	return func(c *Client) {
+		c.authToken = token
+	}
+}
+
+// WithCSRFToken sets a known CSRF token, e.g. one stored at login, so it
+// doesn't need to be fetched before the first mutating request
+func WithCSRFToken(token string) ClientOption {
+	return func(c *Client) {
+		c.csrfToken = token
+	}
+}
+
+// WithBaseURL sets a custom base URL
+func WithBaseURL(url string) ClientOption {
+	return func(c *Client) {
+		c.baseURL = url
+	}
+}
+
+// WithHTTPClient sets a custom HTTP client
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// NewClient creates a new Veo API client
+func NewClient(opts ...ClientOption) *Client {
+	c := &Client{
+		baseURL: DefaultBaseURL,
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+		retryPolicy: DefaultRetryPolicy,
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	// Cookies carry the CSRF token, so every client needs a jar. Copy a
+	// caller-provided client rather than modifying it.
+	if c.httpClient.Jar == nil {
+		httpClient := *c.httpClient
+		httpClient.Jar, _ = cookiejar.New(nil)
+		c.httpClient = &httpClient
+	}
+
+	if c.csrfToken != "" {
+		c.setCSRFToken(c.csrfToken)
+	}
+
+	return c
+}
+
+// newRequest builds an HTTP request with JSON body and authentication headers
+func (c *Client) newRequest(ctx context.Context, method, path string, body interface{}) (*http.Request, error) {
+	var bodyReader io.Reader
+	if body != nil {
+		jsonBody, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		bodyReader = bytes.NewReader(jsonBody)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.resolveURL(path), bodyReader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Add headers
+	req.Header.Set("Content-Type", "application/json")
+	if c.authToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.authToken)
+	}
+	if !isSafeMethod(method) {
+		if token := c.CSRFToken(); token != "" {
+			req.Header.Set("x-csrftoken", token)
+		}
+	}
+
+	return req, nil
+}
+
+// resolveURL returns the URL for an API path. Absolute URLs, such as
+// pagination links returned by the API, are used as given.
+func (c *Client) resolveURL(path string) string {
+	if u, err := url.Parse(path); err == nil && u.IsAbs() {
+		return path
+	}
+	return c.baseURL + path
+}
+
+// doRequest performs an HTTP request with authentication. Mutating requests
+// carry a CSRF token, which is fetched first if needed and refreshed once if
+// the server rejects it. The first fetch is best-effort: the CSRF endpoint is
+// unverified, so if it fails the request is sent without a token.
+func (c *Client) doRequest(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
+	if !isSafeMethod(method) && c.CSRFToken() == "" {
+		_ = c.refreshCSRFToken(ctx)
+	}
+
+	resp, err := c.sendWithRetry(ctx, method, path, body)
+	if err != nil || isSafeMethod(method) || !isCSRFFailure(resp) {
+		return resp, err
+	}
+
+	// Without a fresh token, retrying can't help, so return the rejection
+	if err := c.refreshCSRFToken(ctx); err != nil {
+		return resp, nil
+	}
+	resp.Body.Close()
+
+	return c.sendWithRetry(ctx, method, path, body)
+}
+
+// send performs a single HTTP request
+func (c *Client) send(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
+	if c.limiter != nil {
+		if err := c.limiter.wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := c.newRequest(ctx, method, path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+
+	return resp, nil
+}
+
+// decodeResponse decodes a JSON response into the target interface
+func decodeResponse(resp *http.Response, target interface{}) error {
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return newAPIError(resp)
+	}
+
+	if target != nil {
+		if err := json.NewDecoder(resp.Body).Decode(target); err != nil {
+			return fmt.Errorf("failed to decode response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ListRecordingsOptions contains options for listing recordings
+type ListRecordingsOptions struct {
+	Page     int  // Page number (1-indexed, 0 means first page)
+	FetchAll bool // If true, fetch all pages
+
+	// Filter selects which recordings are listed. It is passed to the API
+	// as is; FilterOwn, the default, is the only value known to work.
+	Filter string
+
+	// Fields are the fields to request, defaulting to DefaultRecordingFields.
+	// Fields without a matching Recording field are kept in Recording.Extra.
+	Fields []string
+
+	// Concurrency is the number of pages fetched at once when FetchAll is
+	// set. Values above 1 fetch the first page, then the remaining pages in
+	// parallel; 0 or 1 fetches pages one at a time.
+	Concurrency int
+}
+
+// ListRecordingsResult contains recordings and metadata
+type ListRecordingsResult struct {
+	Recordings []Recording
+	TotalCount int // Total count from API (x-veo-total-count header)
+}
+
+// ListRecordings lists recordings for a club with pagination support. To
+// stream recordings without holding every page in memory, use Recordings.
+func (c *Client) ListRecordings(ctx context.Context, clubSlug string, opts *ListRecordingsOptions) (*ListRecordingsResult, error) {
+	if opts == nil {
+		opts = &ListRecordingsOptions{Page: 1}
+	}
+
+	if opts.FetchAll && opts.Concurrency > 1 {
+		return c.listRecordingsConcurrently(ctx, clubSlug, opts)
+	}
+
+	result := &ListRecordingsResult{}
+	for page, err := range c.recordingPages(ctx, clubSlug, opts) {
+		if err != nil {
+			return nil, err
+		}
+
+		// Total count only needs to be read once
+		if result.TotalCount == 0 {
+			result.TotalCount = page.totalCount
+		}
+		result.Recordings = append(result.Recordings, page.recordings...)
+
+		if !opts.FetchAll {
+			break
+		}
+	}
+
+	return result, nil
+}
+
+// RecordingDetails represents detailed information about a recording/match
+type RecordingDetails struct {
+	ID                   string                 `json:"id"`
+	Identifier           string                 `json:"identifier"`
+	Slug                 string                 `json:"slug"`
+	Title                string                 `json:"title"`
+	Created              time.Time              `json:"created"`
+	Start                time.Time              `json:"start"`
+	End                  time.Time              `json:"end"`
+	Duration             int                    `json:"duration"`
+	Type                 string                 `json:"type"`
+	OwnTeamHomeOrAway    string                 `json:"own_team_home_or_away"`
+	OpponentTeamName     string                 `json:"opponent_team_name"`
+	OpponentClubName     string                 `json:"opponent_club_name"`
+	OpponentTeamColor    string                 `json:"opponent_team_color"`
+	OpponentShortName    string                 `json:"opponent_short_name"`
+	OwnTeamColor         string                 `json:"own_team_color"`
+	OwnTeamFormation     string                 `json:"own_team_formation"`
+	OpponentTeamFormation string                `json:"opponent_team_formation"`
+	Team                 interface{}            `json:"team"` // Can be string, object, or null
+	ReelURL              string                 `json:"reel_url"` // Full game highlights/reel download URL
+	Info                 map[string]interface{} `json:"info"`
+	Permissions          map[string]interface{} `json:"permissions"`
+}
+
+// GetRecording retrieves detailed information about a specific recording/match
+func (c *Client) GetRecording(ctx context.Context, identifier string) (*RecordingDetails, error) {
+	path := fmt.Sprintf("/matches/%s/", identifier)
+
+	resp, err := c.doRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var details RecordingDetails
+	if err := decodeResponse(resp, &details); err != nil {
+		return nil, err
+	}
+
+	return &details, nil
+}
+
+// Period represents a match period (half)
+type Period struct {
+	PublicIdentifier string `json:"public_identifier"`
+	Timeframe        []int  `json:"timeframe"` // [start_seconds, end_seconds]
+	OwnSide          string `json:"own_side"`  // "left" or "right"
+	Name             string `json:"name"`
+	UserModified     *bool  `json:"user_modified"`
+	IsConfirmed      bool   `json:"is_confirmed"`
+	Duration         int    `json:"duration"` // in seconds
+}
+
+// GetPeriods retrieves period information (kickoff timestamps) for a match
+func (c *Client) GetPeriods(ctx context.Context, slug string) ([]Period, error) {
+	path := fmt.Sprintf("/matches/%s/periods/", slug)
+
+	resp, err := c.doRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var periods []Period
+	if err := decodeResponse(resp, &periods); err != nil {
+		return nil, err
+	}
+
+	return periods, nil
+}
+
+// MatchUpdate contains the match fields to change. Nil fields are not sent,
+// so they are left unchanged by the API.
+type MatchUpdate struct {
+	Title             *string `json:"title,omitempty"`
+	Type              *string `json:"type,omitempty"`                  // match, tournament, training, scrimmage
+	OwnTeamHomeOrAway *string `json:"own_team_home_or_away,omitempty"` // home or away
+	Start             *string `json:"start,omitempty"`                 // local time without zone, e.g. 2025-11-16T12:00:00
+
+	// Team sides. These can be cleared with a NullableString whose Null is true.
+	OpponentClubName      *NullableString `json:"opponent_club_name,omitempty"`
+	OpponentTeamName      *NullableString `json:"opponent_team_name,omitempty"`
+	OpponentTeamColor     *NullableString `json:"opponent_team_color,omitempty"`
+	OpponentShortName     *NullableString `json:"opponent_short_name,omitempty"`
+	OwnTeamColor          *NullableString `json:"own_team_color,omitempty"`
+	OwnTeamFormation      *NullableString `json:"own_team_formation,omitempty"`
+	OpponentTeamFormation *NullableString `json:"opponent_team_formation,omitempty"`
+}
+
+// NullableString is a string field that can be explicitly set to null
+type NullableString struct {
+	Value string
+	Null  bool
+}
+
+// MarshalJSON encodes the value, or null if Null is set
+func (n NullableString) MarshalJSON() ([]byte, error) {
+	if n.Null {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.Value)
+}
+
+// UpdateMatch applies a partial update to a match and returns the updated details
+func (c *Client) UpdateMatch(ctx context.Context, identifier string, update *MatchUpdate) (*RecordingDetails, error) {
+	path := fmt.Sprintf("/matches/%s/", identifier)
+
+	resp, err := c.doRequest(ctx, "PATCH", path, update)
+	if err != nil {
+		return nil, err
+	}
+
+	var details RecordingDetails
+	if err := decodeResponse(resp, &details); err != nil {
+		return nil, err
+	}
+
+	return &details, nil
+}
+
+// ListHighlightsOptions contains options for listing highlights
+type ListHighlightsOptions struct {
+	IncludeAI bool // Include AI-generated highlights
+}
+
+// ListHighlights lists the highlights of a match
+func (c *Client) ListHighlights(ctx context.Context, slug string, opts *ListHighlightsOptions) ([]Highlight, error) {
+	if opts == nil {
+		opts = &ListHighlightsOptions{IncludeAI: true}
+	}
+
+	params := url.Values{}
+	params.Set("include_ai", fmt.Sprintf("%t", opts.IncludeAI))
+	for _, field := range []string{
+		"id", "created", "start", "duration", "thumbnail",
+		"is_ai_generated", "ai_resolution", "videos", "tags", "involved_players",
+	} {
+		params.Add("fields", field)
+	}
+
+	path := fmt.Sprintf("/matches/%s/highlights/?%s", slug, params.Encode())
+
+	resp, err := c.doRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var highlights []Highlight
+	if err := decodeResponse(resp, &highlights); err != nil {
+		return nil, err
+	}
+
+	return highlights, nil
+}
+
+// ListVideos lists the video streams of a match
+func (c *Client) ListVideos(ctx context.Context, slug string) ([]Video, error) {
+	path := fmt.Sprintf("/matches/%s/videos/", slug)
+
+	resp, err := c.doRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var videos []Video
+	if err := decodeResponse(resp, &videos); err != nil {
+		return nil, err
+	}
+
+	return videos, nil
+}
@@ -1,9 +1,14 @@
-package api
+package veo
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 func TestNewClient(t *testing.T) {
@@ -32,7 +37,7 @@ func TestDoRequest(t *testing.T) {
 	defer server.Close()
 
 	c := NewClient(WithBaseURL(server.URL), WithAuthToken("test-token"))
-	resp, err := c.doRequest("GET", "/test", nil)
+	resp, err := c.doRequest(context.Background(), "GET", "/test", nil)
 	if err != nil {
 		t.Fatalf("doRequest failed: %v", err)
 	}
@@ -68,7 +73,7 @@ func TestListRecordingsSinglePage(t *testing.T) {
 	defer server.Close()
 
 	c := NewClient(WithBaseURL(server.URL), WithAuthToken("test-token"))
-	result, err := c.ListRecordings("test-club", nil)
+	result, err := c.ListRecordings(context.Background(), "test-club", nil)
 	if err != nil {
 		t.Fatalf("ListRecordings failed: %v", err)
 	}
@@ -88,13 +93,14 @@ func TestListRecordingsSinglePage(t *testing.T) {
 
 func TestListRecordingsWithPagination(t *testing.T) {
 	requestCount := 0
-	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		requestCount++
 		page := r.URL.Query().Get("page")
 
 		if page == "" || page == "1" {
 			// First page - include Link header with next
-			w.Header().Set("Link", `<http://example.com?page=2>; rel="next"`)
+			w.Header().Set("Link", fmt.Sprintf(`<%s/clubs/test-club/recordings/?page=2>; rel="next"`, server.URL))
 			w.Header().Set("x-veo-total-count", "3")
 			w.WriteHeader(http.StatusOK)
 			w.Write([]byte(`[
@@ -115,7 +121,7 @@ func TestListRecordingsWithPagination(t *testing.T) {
 	c := NewClient(WithBaseURL(server.URL), WithAuthToken("test-token"))
 
 	opts := &ListRecordingsOptions{FetchAll: true}
-	result, err := c.ListRecordings("test-club", opts)
+	result, err := c.ListRecordings(context.Background(), "test-club", opts)
 	if err != nil {
 		t.Fatalf("ListRecordings failed: %v", err)
 	}
@@ -157,7 +163,7 @@ func TestListRecordingsSpecificPage(t *testing.T) {
 	c := NewClient(WithBaseURL(server.URL), WithAuthToken("test-token"))
 
 	opts := &ListRecordingsOptions{Page: 2}
-	result, err := c.ListRecordings("test-club", opts)
+	result, err := c.ListRecordings(context.Background(), "test-club", opts)
 	if err != nil {
 		t.Fatalf("ListRecordings failed: %v", err)
 	}
@@ -171,7 +177,7 @@ func TestListRecordingsSpecificPage(t *testing.T) {
 	}
 }
 
-func TestHasNextPage(t *testing.T) {
+func TestParseLinkHeaderNext(t *testing.T) {
 	tests := []struct {
 		name       string
 		linkHeader string
@@ -196,9 +202,9 @@ func TestHasNextPage(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := hasNextPage(tt.linkHeader)
+			_, result := parseLinkHeader(tt.linkHeader)["next"]
 			if result != tt.expected {
-				t.Errorf("hasNextPage(%q) = %v, expected %v", tt.linkHeader, result, tt.expected)
+				t.Errorf("parseLinkHeader(%q) has next = %v, expected %v", tt.linkHeader, result, tt.expected)
 			}
 		})
 	}
@@ -253,7 +259,7 @@ func TestGetRecording(t *testing.T) {
 	defer server.Close()
 
 	c := NewClient(WithBaseURL(server.URL), WithAuthToken("test-token"))
-	details, err := c.GetRecording("test-id-12345")
+	details, err := c.GetRecording(context.Background(), "test-id-12345")
 	if err != nil {
 		t.Fatalf("GetRecording failed: %v", err)
 	}
@@ -305,8 +311,195 @@ func TestGetRecordingNotFound(t *testing.T) {
 	defer server.Close()
 
 	c := NewClient(WithBaseURL(server.URL), WithAuthToken("test-token"))
-	_, err := c.GetRecording("nonexistent-id")
+	_, err := c.GetRecording(context.Background(), "nonexistent-id")
 	if err == nil {
 		t.Error("expected error for nonexistent recording, got nil")
 	}
 }
+
+func TestUpdateMatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PATCH" {
+			t.Errorf("expected PATCH, got %s", r.Method)
+		}
+
+		if got := r.Header.Get("x-csrftoken"); got != "test-csrf" {
+			t.Errorf("expected x-csrftoken 'test-csrf', got %q", got)
+		}
+
+		expectedPath := "/matches/test-id-12345/"
+		if r.URL.Path != expectedPath {
+			t.Errorf("expected path %s, got %s", expectedPath, r.URL.Path)
+		}
+
+		// Only fields that were set should be sent
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+		if len(body) != 2 {
+			t.Errorf("expected 2 fields in body, got %v", body)
+		}
+		if body["title"] != "New Title" {
+			t.Errorf("expected title 'New Title', got %v", body["title"])
+		}
+		if body["type"] != "tournament" {
+			t.Errorf("expected type 'tournament', got %v", body["type"])
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"identifier": "test-id-12345", "title": "New Title", "type": "tournament"}`))
+	}))
+	defer server.Close()
+
+	title := "New Title"
+	matchType := "tournament"
+	c := NewClient(WithBaseURL(server.URL), WithAuthToken("test-token"), WithCSRFToken("test-csrf"))
+	details, err := c.UpdateMatch(context.Background(), "test-id-12345", &MatchUpdate{Title: &title, Type: &matchType})
+	if err != nil {
+		t.Fatalf("UpdateMatch failed: %v", err)
+	}
+
+	if details.Title != "New Title" {
+		t.Errorf("expected title 'New Title', got %q", details.Title)
+	}
+}
+
+func TestUpdateMatchNullFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("failed to decode request body: %v", err)
+		}
+
+		if body["own_team_formation"] != "4-3-1" {
+			t.Errorf("expected own_team_formation '4-3-1', got %v", body["own_team_formation"])
+		}
+
+		// Cleared fields must be sent as an explicit null
+		value, ok := body["opponent_team_formation"]
+		if !ok || value != nil {
+			t.Errorf("expected opponent_team_formation to be null, got %v (present: %v)", value, ok)
+		}
+
+		if _, ok := body["title"]; ok {
+			t.Error("expected title to be omitted")
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"identifier": "test-id-12345", "own_team_formation": "4-3-1", "opponent_team_formation": null}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(WithBaseURL(server.URL), WithAuthToken("test-token"), WithCSRFToken("test-csrf"))
+	_, err := c.UpdateMatch(context.Background(), "test-id-12345", &MatchUpdate{
+		OwnTeamFormation:      &NullableString{Value: "4-3-1"},
+		OpponentTeamFormation: &NullableString{Null: true},
+	})
+	if err != nil {
+		t.Fatalf("UpdateMatch failed: %v", err)
+	}
+}
+
+func TestListHighlights(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		expectedPath := "/matches/20251116-test-match/highlights/"
+		if r.URL.Path != expectedPath {
+			t.Errorf("expected path %s, got %s", expectedPath, r.URL.Path)
+		}
+
+		if got := r.URL.Query().Get("include_ai"); got != "false" {
+			t.Errorf("expected include_ai=false, got %q", got)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[
+			{
+				"id": "h1",
+				"start": 754.5,
+				"duration": 12,
+				"is_ai_generated": false,
+				"tags": ["goal"],
+				"videos": [{"url": "https://c.veocdn.com/h1.mp4", "width": 1920, "height": 1080}],
+				"involved_players": []
+			}
+		]`))
+	}))
+	defer server.Close()
+
+	c := NewClient(WithBaseURL(server.URL), WithAuthToken("test-token"))
+	highlights, err := c.ListHighlights(context.Background(), "20251116-test-match", &ListHighlightsOptions{IncludeAI: false})
+	if err != nil {
+		t.Fatalf("ListHighlights failed: %v", err)
+	}
+
+	if len(highlights) != 1 {
+		t.Fatalf("expected 1 highlight, got %d", len(highlights))
+	}
+
+	h := highlights[0]
+	if h.Start != 754.5 || h.Duration != 12 {
+		t.Errorf("unexpected start/duration: %v/%v", h.Start, h.Duration)
+	}
+	if len(h.Tags) != 1 || h.Tags[0] != "goal" {
+		t.Errorf("expected tags [goal], got %v", h.Tags)
+	}
+	if len(h.Videos) != 1 || h.Videos[0].URL != "https://c.veocdn.com/h1.mp4" {
+		t.Errorf("unexpected videos: %+v", h.Videos)
+	}
+}
+
+func TestListVideos(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		expectedPath := "/matches/20251116-test-match/videos/"
+		if r.URL.Path != expectedPath {
+			t.Errorf("expected path %s, got %s", expectedPath, r.URL.Path)
+		}
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`[
+			{"id": "v1", "kind": "panorama", "url": "https://c.veocdn.com/pano.mp4", "width": 3840, "height": 1080, "duration": 3410},
+			{"id": "v2", "kind": "follow-cam", "url": "https://c.veocdn.com/follow.mp4", "width": 1920, "height": 1080, "duration": 3410}
+		]`))
+	}))
+	defer server.Close()
+
+	c := NewClient(WithBaseURL(server.URL), WithAuthToken("test-token"))
+	videos, err := c.ListVideos(context.Background(), "20251116-test-match")
+	if err != nil {
+		t.Fatalf("ListVideos failed: %v", err)
+	}
+
+	if len(videos) != 2 {
+		t.Fatalf("expected 2 videos, got %d", len(videos))
+	}
+
+	if videos[1].Kind != "follow-cam" {
+		t.Errorf("expected kind 'follow-cam', got %q", videos[1].Kind)
+	}
+
+	if res := videos[0].Resolution(); res != "3840x1080" {
+		t.Errorf("expected resolution '3840x1080', got %q", res)
+	}
+}
+
+func TestRequestCanceledByContext(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	c := NewClient(WithBaseURL(server.URL), WithAuthToken("test-token"))
+	_, err := c.GetRecording(ctx, "slow-id")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected deadline exceeded error, got %v", err)
+	}
+}
@@ -1,8 +1,11 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/justincampbell/veo/internal/commands"
 	"github.com/spf13/cobra"
@@ -16,15 +19,35 @@ func main() {
 		Short:   "CLI for Veo sports camera",
 		Long:    `A command-line interface for interacting with the Veo sports camera API.`,
 		Version: version,
+		// Errors are printed below with hints for common API failures
+		SilenceErrors: true,
 	}
 
+	// Global flags
+	rootCmd.PersistentFlags().String("profile", "", "Config profile to use (or set VEO_PROFILE)")
+	rootCmd.PersistentFlags().Float64("rate", 0, "Maximum API requests per second (0 for unlimited)")
+
 	// Add subcommands
 	rootCmd.AddCommand(commands.NewListCmd())
 	rootCmd.AddCommand(commands.NewGetCmd())
 	rootCmd.AddCommand(commands.NewUpdateCmd())
+	rootCmd.AddCommand(commands.NewHighlightsCmd())
+	rootCmd.AddCommand(commands.NewVideosCmd())
+	rootCmd.AddCommand(commands.NewPeriodsCmd())
+	rootCmd.AddCommand(commands.NewDownloadCmd())
+	rootCmd.AddCommand(commands.NewSyncCmd())
+	rootCmd.AddCommand(commands.NewLoginCmd())
+	rootCmd.AddCommand(commands.NewLogoutCmd())
+	rootCmd.AddCommand(commands.NewAuthCmd())
+	rootCmd.AddCommand(commands.NewTemplatesHelpCmd())
+	rootCmd.AddCommand(commands.NewSelectorsHelpCmd())
+
+	// Cancel in-flight requests on Ctrl-C
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
-	if err := rootCmd.Execute(); err != nil {
-		fmt.Fprintln(os.Stderr, err)
-		os.Exit(1)
+	if err := rootCmd.ExecuteContext(ctx); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", commands.ErrorMessage(err))
+		os.Exit(commands.ExitCode(err))
 	}
 }
@@ -0,0 +1,85 @@
+package veo
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// csrfCookieName is the cookie holding the CSRF token
+const csrfCookieName = "csrftoken"
+
+// csrfPath is the endpoint assumed to set the csrftoken cookie; it has not
+// been verified against the API
+const csrfPath = "/auth/csrf/"
+
+// CSRFToken returns the current CSRF token from the cookie jar
+func (c *Client) CSRFToken() string {
+	u, err := url.Parse(c.baseURL)
+	if err != nil {
+		return ""
+	}
+
+	for _, cookie := range c.httpClient.Jar.Cookies(u) {
+		if cookie.Name == csrfCookieName {
+			return cookie.Value
+		}
+	}
+
+	return ""
+}
+
+// setCSRFToken stores a CSRF token in the cookie jar
+func (c *Client) setCSRFToken(token string) {
+	u, err := url.Parse(c.baseURL)
+	if err != nil {
+		return
+	}
+
+	c.httpClient.Jar.SetCookies(u, []*http.Cookie{
+		{Name: csrfCookieName, Value: token, Path: "/"},
+	})
+}
+
+// refreshCSRFToken fetches a new csrftoken cookie into the jar
+func (c *Client) refreshCSRFToken(ctx context.Context) error {
+	resp, err := c.sendWithRetry(ctx, "GET", csrfPath, nil)
+	if err != nil {
+		return fmt.Errorf("failed to get CSRF token: %w", err)
+	}
+	if err := decodeResponse(resp, nil); err != nil {
+		return fmt.Errorf("failed to get CSRF token: %w", err)
+	}
+
+	if c.CSRFToken() == "" {
+		return fmt.Errorf("failed to get CSRF token: no %s cookie in response", csrfCookieName)
+	}
+
+	return nil
+}
+
+// isSafeMethod reports whether method is read-only and so needs no CSRF token
+func isSafeMethod(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS":
+		return true
+	}
+	return false
+}
+
+// isCSRFFailure reports whether resp is a 403 caused by a missing or stale
+// CSRF token. The body is restored so it can still be decoded.
+func isCSRFFailure(resp *http.Response) bool {
+	if resp.StatusCode != http.StatusForbidden {
+		return false
+	}
+
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	return bytes.Contains(bytes.ToUpper(body), []byte("CSRF"))
+}
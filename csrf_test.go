@@ -0,0 +1,155 @@
+package veo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCSRFTokenFetchedBeforeMutatingRequest(t *testing.T) {
+	var csrfFetches int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/auth/csrf/":
+			csrfFetches++
+			http.SetCookie(w, &http.Cookie{Name: "csrftoken", Value: "fetched-csrf", Path: "/"})
+			w.WriteHeader(http.StatusNoContent)
+		case "/matches/id/":
+			if r.Method == "PATCH" && r.Header.Get("x-csrftoken") != "fetched-csrf" {
+				t.Errorf("expected fetched CSRF token, got %q", r.Header.Get("x-csrftoken"))
+			}
+			if r.Method == "GET" && r.Header.Get("x-csrftoken") != "" {
+				t.Error("expected no CSRF header on GET")
+			}
+			w.Write([]byte(`{"identifier": "id"}`))
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(WithBaseURL(server.URL), WithAuthToken("test-token"))
+
+	if _, err := c.GetRecording(context.Background(), "id"); err != nil {
+		t.Fatalf("GetRecording failed: %v", err)
+	}
+	if csrfFetches != 0 {
+		t.Errorf("expected no CSRF fetch for GET, got %d", csrfFetches)
+	}
+
+	title := "New"
+	for i := 0; i < 2; i++ {
+		if _, err := c.UpdateMatch(context.Background(), "id", &MatchUpdate{Title: &title}); err != nil {
+			t.Fatalf("UpdateMatch failed: %v", err)
+		}
+	}
+	if csrfFetches != 1 {
+		t.Errorf("expected CSRF token to be fetched once, got %d", csrfFetches)
+	}
+}
+
+func TestCSRFFailureRetriedOnce(t *testing.T) {
+	var patches int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/auth/csrf/":
+			http.SetCookie(w, &http.Cookie{Name: "csrftoken", Value: "fresh-csrf", Path: "/"})
+			w.WriteHeader(http.StatusNoContent)
+		case "/matches/id/":
+			patches++
+			if r.Header.Get("x-csrftoken") != "fresh-csrf" {
+				w.WriteHeader(http.StatusForbidden)
+				w.Write([]byte(`{"detail": "CSRF Failed: CSRF token incorrect."}`))
+				return
+			}
+			w.Write([]byte(`{"identifier": "id", "title": "New"}`))
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(WithBaseURL(server.URL), WithAuthToken("test-token"), WithCSRFToken("stale-csrf"))
+
+	title := "New"
+	details, err := c.UpdateMatch(context.Background(), "id", &MatchUpdate{Title: &title})
+	if err != nil {
+		t.Fatalf("UpdateMatch failed: %v", err)
+	}
+	if details.Title != "New" {
+		t.Errorf("expected title 'New', got %q", details.Title)
+	}
+	if patches != 2 {
+		t.Errorf("expected 2 PATCH attempts, got %d", patches)
+	}
+}
+
+func TestNonCSRFForbiddenNotRetried(t *testing.T) {
+	var patches int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		patches++
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"detail": "You do not have permission to perform this action."}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(WithBaseURL(server.URL), WithAuthToken("test-token"), WithCSRFToken("csrf"))
+
+	title := "New"
+	if _, err := c.UpdateMatch(context.Background(), "id", &MatchUpdate{Title: &title}); err == nil {
+		t.Fatal("expected error for forbidden request")
+	}
+	if patches != 1 {
+		t.Errorf("expected 1 PATCH attempt, got %d", patches)
+	}
+}
+
+func TestCSRFFetchFailureStillSendsRequest(t *testing.T) {
+	var csrfFetches, patches int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/auth/csrf/":
+			csrfFetches++
+			w.WriteHeader(http.StatusNotFound)
+		case "/matches/id/":
+			patches++
+			if got := r.Header.Get("x-csrftoken"); got != "" {
+				t.Errorf("expected no CSRF header, got %q", got)
+			}
+			w.Write([]byte(`{"identifier": "id", "title": "New"}`))
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(WithBaseURL(server.URL), WithAuthToken("test-token"))
+
+	title := "New"
+	details, err := c.UpdateMatch(context.Background(), "id", &MatchUpdate{Title: &title})
+	if err != nil {
+		t.Fatalf("UpdateMatch failed: %v", err)
+	}
+	if details.Title != "New" {
+		t.Errorf("expected title 'New', got %q", details.Title)
+	}
+	if csrfFetches != 1 || patches != 1 {
+		t.Errorf("expected 1 CSRF fetch and 1 PATCH, got %d and %d", csrfFetches, patches)
+	}
+}
+
+func TestCSRFFailureWithoutTokenEndpoint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/auth/csrf/":
+			w.WriteHeader(http.StatusNotFound)
+		case "/matches/id/":
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"detail": "CSRF Failed: CSRF token missing."}`))
+		}
+	}))
+	defer server.Close()
+
+	c := NewClient(WithBaseURL(server.URL), WithAuthToken("test-token"))
+
+	title := "New"
+	_, err := c.UpdateMatch(context.Background(), "id", &MatchUpdate{Title: &title})
+	if !IsForbidden(err) {
+		t.Errorf("expected the CSRF rejection to be returned, got %v", err)
+	}
+}
@@ -0,0 +1,26 @@
+// Package veo is a Go client for the Veo sports camera API
+// (https://app.veo.co).
+//
+// Create a Client with a bearer token from an existing session, and call its
+// methods with a context:
+//
+//	client := veo.NewClient(veo.WithAuthToken(os.Getenv("VEO_TOKEN")))
+//	result, err := client.ListRecordings(ctx, "my-club", &veo.ListRecordingsOptions{FetchAll: true})
+//
+// The client retries transient failures (see RetryPolicy), can be rate
+// limited with WithRateLimit, and handles CSRF tokens for mutating requests.
+// API failures are returned as *APIError; use IsNotFound, IsUnauthorized and
+// friends to inspect them.
+//
+// The Veo API is not officially documented; see docs/api.md in the
+// repository for what is known about it.
+//
+// # Compatibility
+//
+// This package follows semantic versioning. Within a major version, exported
+// identifiers are not removed or changed incompatibly: new methods, options,
+// and struct fields may be added, so construct option structs with field
+// names and don't rely on the exact text of error messages. Fields of the
+// model types mirror the API's JSON and are only removed if Veo removes them
+// upstream. The veo command (cmd/veo) uses only this package's public API.
+package veo
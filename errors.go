@@ -0,0 +1,127 @@
+package veo
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// requestIDHeaders are response headers that may identify a request in
+// Veo's logs, in order of preference
+var requestIDHeaders = []string{"X-Request-Id", "X-Veo-Request-Id", "Cf-Ray"}
+
+// APIError is returned when the API responds with a non-2xx status
+type APIError struct {
+	StatusCode  int
+	Method      string
+	Endpoint    string              // Request path, without query parameters
+	RequestID   string              // Request ID header, if the server sent one
+	Detail      string              // The "detail" message of the error body
+	FieldErrors map[string][]string // Validation errors by field, e.g. for PATCH
+	Body        string              // Raw response body
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("API request failed with status %d (%s %s)", e.StatusCode, e.Method, e.Endpoint)
+
+	switch {
+	case e.Detail != "":
+		msg += ": " + e.Detail
+	case len(e.FieldErrors) > 0:
+		fields := make([]string, 0, len(e.FieldErrors))
+		for field, errs := range e.FieldErrors {
+			fields = append(fields, field+": "+strings.Join(errs, " "))
+		}
+		sort.Strings(fields)
+		msg += ": " + strings.Join(fields, "; ")
+	case e.Body != "":
+		msg += ": " + e.Body
+	}
+
+	if e.RequestID != "" {
+		msg += fmt.Sprintf(" [request ID %s]", e.RequestID)
+	}
+
+	return msg
+}
+
+// newAPIError builds an APIError from a failed response, consuming its body
+func newAPIError(resp *http.Response) *APIError {
+	body, _ := io.ReadAll(resp.Body)
+
+	e := &APIError{
+		StatusCode: resp.StatusCode,
+		Body:       strings.TrimSpace(string(body)),
+	}
+
+	if resp.Request != nil {
+		e.Method = resp.Request.Method
+		e.Endpoint = resp.Request.URL.Path
+	}
+
+	for _, header := range requestIDHeaders {
+		if id := resp.Header.Get(header); id != "" {
+			e.RequestID = id
+			break
+		}
+	}
+
+	// Error bodies look like {"detail": "..."} or {"field": ["message"]}
+	var fields map[string]json.RawMessage
+	if json.Unmarshal(body, &fields) == nil {
+		for name, raw := range fields {
+			var detail string
+			if name == "detail" && json.Unmarshal(raw, &detail) == nil {
+				e.Detail = detail
+				continue
+			}
+
+			var messages []string
+			if json.Unmarshal(raw, &messages) == nil {
+				if e.FieldErrors == nil {
+					e.FieldErrors = make(map[string][]string)
+				}
+				e.FieldErrors[name] = messages
+			}
+		}
+	}
+
+	return e
+}
+
+// hasStatus reports whether err is an APIError with the given status
+func hasStatus(err error, status int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == status
+}
+
+// IsUnauthorized reports whether err is a 401, e.g. an expired token
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized)
+}
+
+// IsForbidden reports whether err is a 403
+func IsForbidden(err error) bool {
+	return hasStatus(err, http.StatusForbidden)
+}
+
+// IsNotFound reports whether err is a 404
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsRateLimited reports whether err is a 429
+func IsRateLimited(err error) bool {
+	return hasStatus(err, http.StatusTooManyRequests)
+}
+
+// IsServerError reports whether err is a 5xx
+func IsServerError(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode >= 500
+}
@@ -0,0 +1,103 @@
+package veo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAPIError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-123")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"detail": "Not found."}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(WithBaseURL(server.URL), WithAuthToken("test-token"))
+	_, err := c.GetRecording(context.Background(), "missing-id")
+
+	// Callers wrap errors, so check through a wrapper
+	err = fmt.Errorf("failed to get recording: %w", err)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %T: %v", err, err)
+	}
+
+	if apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("expected status 404, got %d", apiErr.StatusCode)
+	}
+	if apiErr.Method != "GET" || apiErr.Endpoint != "/matches/missing-id/" {
+		t.Errorf("unexpected request: %s %s", apiErr.Method, apiErr.Endpoint)
+	}
+	if apiErr.RequestID != "req-123" {
+		t.Errorf("expected request ID 'req-123', got %q", apiErr.RequestID)
+	}
+	if apiErr.Detail != "Not found." {
+		t.Errorf("expected detail 'Not found.', got %q", apiErr.Detail)
+	}
+
+	if !IsNotFound(err) {
+		t.Error("expected IsNotFound to be true")
+	}
+	if IsUnauthorized(err) || IsRateLimited(err) || IsServerError(err) {
+		t.Error("expected other status helpers to be false")
+	}
+
+	msg := err.Error()
+	if !strings.Contains(msg, "status 404") || !strings.Contains(msg, "req-123") {
+		t.Errorf("unexpected error message: %s", msg)
+	}
+}
+
+func TestAPIErrorFieldErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"type": ["\"final\" is not a valid choice."]}`))
+	}))
+	defer server.Close()
+
+	matchType := "final"
+	c := NewClient(WithBaseURL(server.URL), WithCSRFToken("csrf"))
+	_, err := c.UpdateMatch(context.Background(), "id", &MatchUpdate{Type: &matchType})
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected *APIError, got %T: %v", err, err)
+	}
+	if got := apiErr.FieldErrors["type"]; len(got) != 1 {
+		t.Errorf("expected a field error for type, got %v", apiErr.FieldErrors)
+	}
+	if !strings.Contains(err.Error(), "type: ") {
+		t.Errorf("expected field errors in message, got %s", err)
+	}
+}
+
+func TestStatusHelpers(t *testing.T) {
+	tests := []struct {
+		status int
+		check  func(error) bool
+	}{
+		{status: http.StatusUnauthorized, check: IsUnauthorized},
+		{status: http.StatusForbidden, check: IsForbidden},
+		{status: http.StatusNotFound, check: IsNotFound},
+		{status: http.StatusTooManyRequests, check: IsRateLimited},
+		{status: http.StatusBadGateway, check: IsServerError},
+	}
+
+	for _, tt := range tests {
+		err := &APIError{StatusCode: tt.status}
+		if !tt.check(err) {
+			t.Errorf("expected helper to match status %d", tt.status)
+		}
+	}
+
+	if IsNotFound(errors.New("plain error")) {
+		t.Error("expected plain errors not to match")
+	}
+}
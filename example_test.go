@@ -0,0 +1,65 @@
+package veo_test
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/justincampbell/veo"
+)
+
+// newExampleServer returns a stand-in for the Veo API so the examples run
+// without network access
+func newExampleServer() *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/clubs/my-club/recordings/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("x-veo-total-count", "2")
+		fmt.Fprint(w, `[
+			{"slug": "20251116-match-rivals", "title": "Match - Rivals", "duration": 3259},
+			{"slug": "20251109-training", "title": "Training", "duration": 2700}
+		]`)
+	})
+	mux.HandleFunc("/matches/missing/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"detail": "Not found."}`)
+	})
+	return httptest.NewServer(mux)
+}
+
+func ExampleClient_ListRecordings() {
+	server := newExampleServer()
+	defer server.Close()
+
+	client := veo.NewClient(
+		veo.WithAuthToken("my-token"),
+		veo.WithBaseURL(server.URL), // Omit to use DefaultBaseURL
+	)
+
+	result, err := client.ListRecordings(context.Background(), "my-club", &veo.ListRecordingsOptions{FetchAll: true})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, r := range result.Recordings {
+		fmt.Printf("%s (%d min)\n", r.Title, r.Duration/60)
+	}
+	// Output:
+	// Match - Rivals (54 min)
+	// Training (45 min)
+}
+
+func ExampleIsNotFound() {
+	server := newExampleServer()
+	defer server.Close()
+
+	client := veo.NewClient(veo.WithAuthToken("my-token"), veo.WithBaseURL(server.URL))
+
+	_, err := client.GetRecording(context.Background(), "missing")
+	if veo.IsNotFound(err) {
+		fmt.Println("no such recording")
+	}
+	// Output:
+	// no such recording
+}
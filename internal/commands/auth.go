@@ -0,0 +1,215 @@
+package commands
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/justincampbell/veo"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// NewLoginCmd creates the login command
+func NewLoginCmd() *cobra.Command {
+	var withToken bool
+
+	cmd := &cobra.Command{
+		Use:   "login",
+		Short: "Store a Veo token",
+		Long: `Store a bearer token copied from a browser session in the config file
+(created with 0600 permissions). On a terminal the token is prompted for
+without being shown; with --with-token it is read from stdin instead.
+
+Copy the token from your browser's DevTools while logged into app.veo.co
+(Network tab, any API request, Authorization header, without "Bearer ").
+
+Credentials are saved to the profile selected with --profile, or the default
+profile. Logging in with an email and password isn't supported, because Veo's
+login exchange hasn't been captured.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path, cfg, err := loadConfig()
+			if err != nil {
+				return err
+			}
+
+			token, err := readToken(cmd.InOrStdin(), withToken)
+			if err != nil {
+				return err
+			}
+
+			name := cfg.ProfileName(selectedProfile(cmd))
+			profile := cfg.EnsureProfile(name)
+			profile.Token = token
+			// A CSRF token from an earlier session is stale, and is fetched
+			// again when needed
+			profile.CSRFToken = ""
+
+			if err := cfg.Save(path); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(os.Stderr, "Logged in. Credentials saved to profile %q in %s\n", name, path)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&withToken, "with-token", false, "Read the bearer token from stdin")
+
+	return cmd
+}
+
+// NewLogoutCmd creates the logout command
+func NewLogoutCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logout",
+		Short: "Remove stored credentials",
+		Long:  `Remove the stored bearer and CSRF tokens from the selected config profile.`,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			path, cfg, err := loadConfig()
+			if err != nil {
+				return err
+			}
+
+			name := cfg.ProfileName(selectedProfile(cmd))
+			profile, ok := cfg.Profiles[name]
+			if !ok || profile == nil || (profile.Token == "" && profile.CSRFToken == "") {
+				return fmt.Errorf("not logged in (profile %q has no stored credentials)", name)
+			}
+
+			profile.Token = ""
+			profile.CSRFToken = ""
+
+			if err := cfg.Save(path); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(os.Stderr, "Logged out of profile %q\n", name)
+			if os.Getenv("VEO_TOKEN") != "" {
+				fmt.Fprintln(os.Stderr, "Note: VEO_TOKEN is still set in your environment")
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// NewAuthCmd creates the auth command
+func NewAuthCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auth",
+		Short: "Manage authentication",
+	}
+
+	cmd.AddCommand(newAuthStatusCmd())
+
+	return cmd
+}
+
+// newAuthStatusCmd creates the auth status subcommand
+func newAuthStatusCmd() *cobra.Command {
+	var clubSlug string
+
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Show authentication status",
+		Long: `Show which profile and token are in use. If a club is configured, the
+token is checked against the API.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			s, err := loadSettings(cmd, clubSlug)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			fmt.Fprintf(out, "Profile:     %s\n", s.Profile)
+			fmt.Fprintf(out, "Config:      %s\n", s.ConfigPath)
+
+			if err := s.requireToken(); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(out, "Token:       %s (from %s)\n", maskToken(s.Token), s.TokenSource)
+			if s.CSRFToken != "" {
+				fmt.Fprintf(out, "CSRF Token:  %s\n", maskToken(s.CSRFToken))
+			}
+
+			if s.Club == "" {
+				fmt.Fprintln(out, "Status:      not verified (no club configured)")
+				return nil
+			}
+
+			client := s.newClient()
+			if _, err := client.ListRecordings(cmd.Context(), s.Club, &veo.ListRecordingsOptions{Page: 1}); err != nil {
+				fmt.Fprintln(out, "Status:      invalid")
+				return fmt.Errorf("token check failed: %w", err)
+			}
+
+			fmt.Fprintf(out, "Status:      valid (club %s)\n", s.Club)
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&clubSlug, "club", "c", "", "Club slug to verify the token against")
+
+	return cmd
+}
+
+// readToken reads a bearer token from in: a line of input with fromStdin,
+// or otherwise from a hidden prompt, which needs in to be a terminal
+func readToken(in io.Reader, fromStdin bool) (string, error) {
+	var token string
+	if fromStdin {
+		line, err := readLine(bufio.NewReader(in))
+		if err != nil {
+			return "", fmt.Errorf("failed to read token: %w", err)
+		}
+		token = line
+	} else {
+		f, ok := in.(*os.File)
+		if !ok || !term.IsTerminal(int(f.Fd())) {
+			return "", fmt.Errorf("--with-token is required when not running interactively")
+		}
+
+		fmt.Fprint(os.Stderr, "Token: ")
+		line, err := term.ReadPassword(int(f.Fd()))
+		fmt.Fprintln(os.Stderr)
+		if err != nil {
+			return "", fmt.Errorf("failed to read token: %w", err)
+		}
+		token = strings.TrimSpace(string(line))
+	}
+
+	token = strings.TrimSpace(strings.TrimPrefix(token, "Bearer "))
+	if token == "" {
+		return "", fmt.Errorf("no token given")
+	}
+	return token, nil
+}
+
+// readLine reads a single trimmed line
+func readLine(in *bufio.Reader) (string, error) {
+	line, err := in.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		return "", err
+	}
+	return strings.TrimSpace(line), nil
+}
+
+// maskToken hides all but the last few characters of a token
+func maskToken(token string) string {
+	const visible = 4
+	if len(token) <= visible {
+		return strings.Repeat("*", len(token))
+	}
+	return strings.Repeat("*", 8) + token[len(token)-visible:]
+}
@@ -0,0 +1,141 @@
+package commands
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/justincampbell/veo/internal/config"
+)
+
+func TestLoginAndLogout(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	t.Setenv("VEO_CONFIG", path)
+	t.Setenv("VEO_PROFILE", "")
+
+	login := NewLoginCmd()
+	login.SetArgs([]string{"--with-token"})
+	login.SetIn(strings.NewReader("Bearer pasted-token\n"))
+	if err := login.Execute(); err != nil {
+		t.Fatalf("login failed: %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0600 {
+		t.Errorf("expected config permissions 0600, got %o", perm)
+	}
+
+	cfg, err := config.Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if profile := cfg.Profiles[config.DefaultProfileName]; profile == nil || profile.Token != "pasted-token" {
+		t.Fatalf("expected the pasted token to be stored, got %+v", profile)
+	}
+
+	logout := NewLogoutCmd()
+	logout.SetArgs([]string{})
+	if err := logout.Execute(); err != nil {
+		t.Fatalf("logout failed: %v", err)
+	}
+
+	cfg, err = config.Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if profile := cfg.Profiles[config.DefaultProfileName]; profile.Token != "" || profile.CSRFToken != "" {
+		t.Errorf("expected credentials to be removed, got %+v", profile)
+	}
+}
+
+func TestLoginErrors(t *testing.T) {
+	t.Setenv("VEO_CONFIG", filepath.Join(t.TempDir(), "config.yaml"))
+	t.Setenv("VEO_PROFILE", "")
+
+	tests := []struct {
+		name     string
+		args     []string
+		input    string
+		expected string
+	}{
+		{"not a terminal", []string{}, "pasted-token\n", "--with-token is required"},
+		{"empty token", []string{"--with-token"}, "\n", "no token given"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			login := NewLoginCmd()
+			login.SetArgs(tt.args)
+			login.SetIn(strings.NewReader(tt.input))
+			if err := login.Execute(); err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("expected error containing %q, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestAuthStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer good-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte(`[]`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		token    string
+		club     string
+		expected []string
+		errCode  int
+	}{
+		{"valid", "good-token", "test-club", []string{"Token:       ********oken (from VEO_TOKEN environment variable)", "Status:      valid (club test-club)"}, 0},
+		{"invalid", "bad-token", "test-club", []string{"Status:      invalid"}, ExitUnauthorized},
+		{"no club", "good-token", "", []string{"Status:      not verified (no club configured)"}, 0},
+		{"no token", "", "test-club", []string{"Profile:     default"}, ExitUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("VEO_CONFIG", filepath.Join(t.TempDir(), "config.yaml"))
+			t.Setenv("VEO_BASE_URL", server.URL)
+			t.Setenv("VEO_PROFILE", "")
+			t.Setenv("VEO_TOKEN", tt.token)
+			t.Setenv("VEO_CLUB", tt.club)
+
+			var out strings.Builder
+			cmd := NewAuthCmd()
+			cmd.SetArgs([]string{"status"})
+			cmd.SetOut(&out)
+			cmd.SetErr(io.Discard)
+			err := cmd.Execute()
+
+			if code := ExitCode(err); code != tt.errCode {
+				t.Errorf("expected exit code %d, got %d (%v)", tt.errCode, code, err)
+			}
+			for _, line := range tt.expected {
+				if !strings.Contains(out.String(), line) {
+					t.Errorf("expected output to contain %q, got:\n%s", line, out.String())
+				}
+			}
+		})
+	}
+}
+
+func TestMaskToken(t *testing.T) {
+	if got := maskToken("abcdefghijkl"); got != "********ijkl" {
+		t.Errorf("maskToken = %q", got)
+	}
+	if got := maskToken("abc"); got != "***" {
+		t.Errorf("maskToken = %q", got)
+	}
+}
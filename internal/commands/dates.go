@@ -0,0 +1,96 @@
+package commands
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+// seasonStartMonth is the month a season starts in. Seasons run from
+// August 1 to July 31.
+const seasonStartMonth = time.August
+
+// relativeAgoPattern matches relative dates such as 7d, 2w, 3m or 1y
+var relativeAgoPattern = regexp.MustCompile(`^(\d+)([dwmy])$`)
+
+// parseDateRange parses --since and --until dates in local time. The
+// returned until is the end of the period given, so the range includes it.
+// See parseDatePeriod for the accepted values.
+func parseDateRange(since, until string) (time.Time, time.Time, error) {
+	now := time.Now()
+
+	var sinceDate, untilDate time.Time
+	if since != "" {
+		start, _, err := parseDatePeriod(since, now)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid --since: %w", err)
+		}
+		sinceDate = start
+	}
+	if until != "" {
+		_, end, err := parseDatePeriod(until, now)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid --until: %w", err)
+		}
+		untilDate = end
+	}
+
+	return sinceDate, untilDate, nil
+}
+
+// parseDatePeriod parses a date into the period [start, end) it covers, in
+// local time. Accepted values are:
+//
+//	2025-11-16                  that day
+//	today, yesterday            that day
+//	7d, 2w, 3m, 1y              the day that many days/weeks/months/years ago
+//	this-season, last-season    August 1 to July 31
+func parseDatePeriod(value string, now time.Time) (time.Time, time.Time, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	day := func(t time.Time) (time.Time, time.Time, error) {
+		return t, t.AddDate(0, 0, 1), nil
+	}
+
+	switch value {
+	case "today":
+		return day(today)
+	case "yesterday":
+		return day(today.AddDate(0, 0, -1))
+	case "this-season":
+		start := seasonStart(today)
+		return start, start.AddDate(1, 0, 0), nil
+	case "last-season":
+		start := seasonStart(today).AddDate(-1, 0, 0)
+		return start, start.AddDate(1, 0, 0), nil
+	}
+
+	if m := relativeAgoPattern.FindStringSubmatch(value); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		switch m[2] {
+		case "d":
+			return day(today.AddDate(0, 0, -n))
+		case "w":
+			return day(today.AddDate(0, 0, -7*n))
+		case "m":
+			return day(today.AddDate(0, -n, 0))
+		case "y":
+			return day(today.AddDate(-n, 0, 0))
+		}
+	}
+
+	if t, err := time.ParseInLocation("2006-01-02", value, time.Local); err == nil {
+		return day(t)
+	}
+
+	return time.Time{}, time.Time{}, fmt.Errorf("%q: expected YYYY-MM-DD, today, yesterday, 7d/2w/3m/1y, this-season or last-season", value)
+}
+
+// seasonStart returns the start of the season containing t
+func seasonStart(t time.Time) time.Time {
+	year := t.Year()
+	if t.Month() < seasonStartMonth {
+		year--
+	}
+	return time.Date(year, seasonStartMonth, 1, 0, 0, 0, 0, time.Local)
+}
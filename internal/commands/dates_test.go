@@ -0,0 +1,65 @@
+package commands
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseDatePeriod(t *testing.T) {
+	now := time.Date(2025, 11, 20, 15, 30, 0, 0, time.Local)
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.Local)
+	}
+
+	tests := []struct {
+		value string
+		start time.Time
+		end   time.Time
+	}{
+		{value: "2025-11-16", start: date(2025, 11, 16), end: date(2025, 11, 17)},
+		{value: "today", start: date(2025, 11, 20), end: date(2025, 11, 21)},
+		{value: "yesterday", start: date(2025, 11, 19), end: date(2025, 11, 20)},
+		{value: "7d", start: date(2025, 11, 13), end: date(2025, 11, 14)},
+		{value: "2w", start: date(2025, 11, 6), end: date(2025, 11, 7)},
+		{value: "3m", start: date(2025, 8, 20), end: date(2025, 8, 21)},
+		{value: "1y", start: date(2024, 11, 20), end: date(2024, 11, 21)},
+		{value: "this-season", start: date(2025, 8, 1), end: date(2026, 8, 1)},
+		{value: "last-season", start: date(2024, 8, 1), end: date(2025, 8, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			start, end, err := parseDatePeriod(tt.value, now)
+			if err != nil {
+				t.Fatalf("parseDatePeriod(%q) failed: %v", tt.value, err)
+			}
+			if !start.Equal(tt.start) || !end.Equal(tt.end) {
+				t.Errorf("parseDatePeriod(%q) = [%v, %v), expected [%v, %v)", tt.value, start, end, tt.start, tt.end)
+			}
+		})
+	}
+
+	for _, value := range []string{"", "last-week", "7x", "2025-13-01"} {
+		if _, _, err := parseDatePeriod(value, now); err == nil {
+			t.Errorf("expected error for %q", value)
+		}
+	}
+}
+
+func TestSeasonStart(t *testing.T) {
+	tests := []struct {
+		date     time.Time
+		expected int
+	}{
+		{date: time.Date(2025, 7, 31, 23, 0, 0, 0, time.Local), expected: 2024},
+		{date: time.Date(2025, 8, 1, 0, 0, 0, 0, time.Local), expected: 2025},
+		{date: time.Date(2026, 1, 15, 0, 0, 0, 0, time.Local), expected: 2025},
+	}
+
+	for _, tt := range tests {
+		start := seasonStart(tt.date)
+		if start.Year() != tt.expected || start.Month() != time.August || start.Day() != 1 {
+			t.Errorf("seasonStart(%v) = %v, expected August 1 %d", tt.date, start, tt.expected)
+		}
+	}
+}
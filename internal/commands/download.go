@@ -0,0 +1,466 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+
+	"github.com/justincampbell/veo"
+	"github.com/justincampbell/veo/internal/download"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// defaultFilenameTemplate names downloads after the match date and title
+const defaultFilenameTemplate = "{{.Date}} {{.Title}}.mp4"
+
+// NewDownloadCmd creates the download command
+func NewDownloadCmd() *cobra.Command {
+	var clubSlug string
+	var dir string
+	var nameTemplate string
+	var videoSelector string
+	var all bool
+	var since, until string
+	var workers int
+	var limitRate string
+
+	cmd := &cobra.Command{
+		Use:   "download [recording-id|latest]",
+		Short: "Download a recording",
+		Long: `Download the reel of a recording, or another video stream chosen with
+--video (by ID or kind, see "veo videos").
+
+Interrupted downloads are resumed when run again, and files that already exist
+complete are skipped. The filename is a Go template with the fields .Date,
+.Time, .Year, .Title, .Opponent, .Type, .Slug and .ID, for example:
+
+  veo download latest --name "{{.Year}}/{{.Date}} vs {{.Opponent}}.mp4"
+
+Use --all to download every recording of the club, optionally limited to a
+date range with --since and --until. Recordings that would be saved under the
+same name, such as two sessions with the same date and title, get their ID
+added to it.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if all && len(args) > 0 {
+				return fmt.Errorf("--all cannot be combined with a recording argument")
+			}
+			if !all && len(args) == 0 && terminalPicker(cmd) == nil {
+				return fmt.Errorf("a recording argument or --all is required")
+			}
+			if !all && (since != "" || until != "") {
+				return fmt.Errorf("--since and --until require --all")
+			}
+
+			tmpl, err := template.New("name").Parse(nameTemplate)
+			if err != nil {
+				return fmt.Errorf("invalid --name template: %w", err)
+			}
+
+			d := download.New()
+			d.SkipExisting = true
+			if limitRate != "" {
+				rate, err := parseByteSize(limitRate)
+				if err != nil {
+					return fmt.Errorf("invalid --limit-rate: %w", err)
+				}
+				d.Limiter = download.NewLimiter(rate)
+			}
+
+			s, err := loadSettings(cmd, clubSlug)
+			if err != nil {
+				return err
+			}
+			if err := s.requireToken(); err != nil {
+				return err
+			}
+
+			// Create API client
+			client := s.newClient()
+
+			job := &downloadJob{
+				client:   client,
+				d:        d,
+				tmpl:     tmpl,
+				dir:      dir,
+				selector: videoSelector,
+			}
+
+			if all {
+				if err := s.requireClub(); err != nil {
+					return err
+				}
+				sinceDate, untilDate, err := parseDateRange(since, until)
+				if err != nil {
+					return err
+				}
+				filter := &recordingFilter{since: sinceDate, until: untilDate}
+				return runBulkDownload(cmd.Context(), job, s.Club, filter, workers)
+			}
+
+			sel, err := resolveRecordingArg(cmd, client, s, args)
+			if err != nil {
+				return err
+			}
+
+			if term.IsTerminal(int(os.Stderr.Fd())) {
+				d.Progress = os.Stderr
+			}
+
+			result, err := job.run(cmd.Context(), sel.id)
+			if err != nil {
+				return err
+			}
+
+			if result.Skipped {
+				fmt.Fprintf(os.Stderr, "Already downloaded: %s\n", result.Path)
+			} else {
+				fmt.Fprintf(os.Stderr, "Saved %s (%d bytes)\n", result.Path, result.Size)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&clubSlug, "club", "c", "", "Club slug (required to select recordings by other than ID, or set VEO_CLUB or a config profile)")
+	cmd.Flags().StringVarP(&dir, "dir", "d", ".", "Directory to download into")
+	cmd.Flags().StringVar(&nameTemplate, "name", defaultFilenameTemplate, "Filename template")
+	cmd.Flags().StringVar(&videoSelector, "video", "", "Video ID or kind to download instead of the reel")
+	cmd.Flags().BoolVarP(&all, "all", "a", false, "Download all recordings of the club")
+	cmd.Flags().StringVar(&since, "since", "", "With --all, only recordings on or after this date (YYYY-MM-DD, 7d, last-season, ...)")
+	cmd.Flags().StringVar(&until, "until", "", "With --all, only recordings on or before this date (YYYY-MM-DD, 7d, last-season, ...)")
+	cmd.Flags().IntVarP(&workers, "workers", "w", 4, "With --all, number of concurrent downloads")
+	cmd.Flags().StringVar(&limitRate, "limit-rate", "", "Total bandwidth cap in bytes per second (e.g. 500K, 5M)")
+
+	return cmd
+}
+
+// downloadJob holds what is needed to download one recording
+type downloadJob struct {
+	client   *veo.Client
+	d        *download.Downloader
+	tmpl     *template.Template
+	dir      string
+	selector string
+}
+
+// run downloads a single recording
+func (j *downloadJob) run(ctx context.Context, recordingID string) (*download.Result, error) {
+	videoURL, name, err := j.prepare(ctx, recordingID)
+	if err != nil {
+		return nil, err
+	}
+
+	return j.d.Download(ctx, videoURL, filepath.Join(j.dir, name))
+}
+
+// prepare fetches a recording and returns the URL to download and the file
+// name to save it as
+func (j *downloadJob) prepare(ctx context.Context, recordingID string) (videoURL, name string, err error) {
+	details, err := j.client.GetRecording(ctx, recordingID)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get recording: %w", err)
+	}
+
+	videoURL, err = selectVideoURL(ctx, j.client, details, j.selector)
+	if err != nil {
+		return "", "", err
+	}
+
+	name, err = renderFilename(j.tmpl, details)
+	if err != nil {
+		return "", "", err
+	}
+
+	return videoURL, name, nil
+}
+
+// bulkItem is one recording of a bulk download
+type bulkItem struct {
+	recording veo.Recording
+	videoURL  string
+	name      string
+	result    *download.Result
+	err       error
+}
+
+// runBulkDownload downloads every recording of a club matching filter using a fixed number of workers, then prints a summary. All file names are
+// worked out before downloading, so recordings whose names clash are never
+// written to the same file.
+func runBulkDownload(ctx context.Context, job *downloadJob, clubSlug string, filter *recordingFilter, workers int) error {
+	listResult, err := job.client.ListRecordings(ctx, clubSlug, &veo.ListRecordingsOptions{FetchAll: true, Concurrency: defaultPageWorkers})
+	if err != nil {
+		return fmt.Errorf("failed to list recordings: %w", err)
+	}
+
+	var items []bulkItem
+	for _, r := range listResult.Recordings {
+		if filter.match(r) {
+			items = append(items, bulkItem{recording: r})
+		}
+	}
+	if len(items) == 0 {
+		fmt.Fprintln(os.Stderr, "No recordings to download")
+		return nil
+	}
+
+	var mu sync.Mutex
+	var finished int
+	report := func(item *bulkItem) {
+		mu.Lock()
+		defer mu.Unlock()
+		finished++
+		switch {
+		case item.err != nil:
+			fmt.Fprintf(os.Stderr, "[%d/%d] Failed %s: %v\n", finished, len(items), item.recording.Title, item.err)
+		case item.result.Skipped:
+			fmt.Fprintf(os.Stderr, "[%d/%d] Skipped %s (already downloaded)\n", finished, len(items), item.result.Path)
+		default:
+			fmt.Fprintf(os.Stderr, "[%d/%d] Saved %s\n", finished, len(items), item.result.Path)
+		}
+	}
+
+	forEachConcurrently(ctx, len(items), workers, func(i int) {
+		item := &items[i]
+		item.videoURL, item.name, item.err = job.prepare(ctx, item.recording.Identifier)
+		if item.err != nil {
+			report(item)
+		}
+	})
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("download canceled: %w", err)
+	}
+
+	if err := uniqueFilenames(items); err != nil {
+		return err
+	}
+
+	forEachConcurrently(ctx, len(items), workers, func(i int) {
+		item := &items[i]
+		if item.err != nil {
+			return
+		}
+		item.result, item.err = job.d.Download(ctx, item.videoURL, filepath.Join(job.dir, item.name))
+		report(item)
+	})
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("download canceled: %w", err)
+	}
+
+	// Summary
+	var downloaded, skipped int
+	var bytes int64
+	var failures []string
+	for _, item := range items {
+		switch {
+		case item.err != nil:
+			failures = append(failures, fmt.Sprintf("  %s  %s: %v", item.recording.Identifier, item.recording.Title, item.err))
+		case item.result.Skipped:
+			skipped++
+		default:
+			downloaded++
+			bytes += item.result.Written
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "\nDownloaded: %d (%d bytes)\nSkipped:    %d\nFailed:     %d\n", downloaded, bytes, skipped, len(failures))
+	if len(failures) > 0 {
+		fmt.Fprintln(os.Stderr, strings.Join(failures, "\n"))
+		return fmt.Errorf("%d of %d downloads failed", len(failures), len(items))
+	}
+
+	return nil
+}
+
+// forEachConcurrently calls fn with each index below n, using the given
+// number of workers. It stops handing out indexes once ctx is done.
+func forEachConcurrently(ctx context.Context, n, workers int, fn func(i int)) {
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range max(workers, 1) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				fn(i)
+			}
+		}()
+	}
+
+dispatch:
+	for i := range n {
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(indexes)
+	wg.Wait()
+}
+
+// uniqueFilenames adds the recording ID to file names shared by several
+// recordings, such as two sessions with the same date and title. Names are
+// compared ignoring case, as some file systems do. Items that failed are
+// left alone.
+func uniqueFilenames(items []bulkItem) error {
+	key := func(name string) string { return strings.ToLower(filepath.Clean(name)) }
+
+	counts := make(map[string]int)
+	for _, item := range items {
+		if item.err == nil {
+			counts[key(item.name)]++
+		}
+	}
+
+	for i := range items {
+		item := &items[i]
+		if item.err != nil || counts[key(item.name)] < 2 {
+			continue
+		}
+		ext := filepath.Ext(item.name)
+		item.name = fmt.Sprintf("%s (%s)%s", strings.TrimSuffix(item.name, ext), sanitizeFilename(item.recording.Identifier), ext)
+	}
+
+	// The IDs could in theory clash with another recording's name
+	names := make(map[string]string)
+	for _, item := range items {
+		if item.err != nil {
+			continue
+		}
+		if other, ok := names[key(item.name)]; ok {
+			return fmt.Errorf("recordings %s and %s would both be saved as %q; add {{.ID}} to --name", other, item.recording.Identifier, item.name)
+		}
+		names[key(item.name)] = item.recording.Identifier
+	}
+
+	return nil
+}
+
+// parseByteSize parses sizes such as 500K, 5M or 1G (binary units)
+func parseByteSize(s string) (int64, error) {
+	multiplier := int64(1)
+	number := strings.ToUpper(strings.TrimSpace(s))
+	number = strings.TrimSuffix(strings.TrimSuffix(number, "B"), "I")
+
+	switch {
+	case strings.HasSuffix(number, "K"):
+		multiplier = 1 << 10
+	case strings.HasSuffix(number, "M"):
+		multiplier = 1 << 20
+	case strings.HasSuffix(number, "G"):
+		multiplier = 1 << 30
+	}
+	if multiplier > 1 {
+		number = number[:len(number)-1]
+	}
+
+	value, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid size %q", s)
+	}
+
+	// Sizes under a byte, such as 0.5, would round down to 0
+	size := value * float64(multiplier)
+	if size < 1 || math.IsInf(size, 0) {
+		return 0, fmt.Errorf("invalid size %q: must be at least 1 byte", s)
+	}
+
+	return int64(size), nil
+}
+
+// selectVideoURL returns the URL to download: the reel by default, or the
+// video matching selector by ID or kind
+func selectVideoURL(ctx context.Context, client *veo.Client, details *veo.RecordingDetails, selector string) (string, error) {
+	if selector == "" {
+		if details.ReelURL == "" {
+			return "", fmt.Errorf("recording has no reel; choose a stream with --video (see 'veo videos %s')", details.Identifier)
+		}
+		return details.ReelURL, nil
+	}
+
+	videos, err := client.ListVideos(ctx, details.Slug)
+	if err != nil {
+		return "", fmt.Errorf("failed to list videos: %w", err)
+	}
+
+	video, err := findVideo(videos, selector)
+	if err != nil {
+		return "", err
+	}
+
+	return video.URL, nil
+}
+
+// findVideo returns the video whose ID or kind matches selector
+func findVideo(videos []veo.Video, selector string) (*veo.Video, error) {
+	for i, v := range videos {
+		if v.ID == selector || strings.EqualFold(v.Kind, selector) {
+			return &videos[i], nil
+		}
+	}
+
+	var available []string
+	for _, v := range videos {
+		available = append(available, fmt.Sprintf("%s (%s)", v.ID, v.Kind))
+	}
+	return nil, fmt.Errorf("no video matching %q (available: %s)", selector, strings.Join(available, ", "))
+}
+
+// filenameData holds the recording fields available to filename templates
+type filenameData struct {
+	Date     string // Match date, YYYY-MM-DD
+	Time     string // Kickoff time, HHMM
+	Year     string
+	Title    string
+	Opponent string
+	Type     string
+	Slug     string
+	ID       string
+}
+
+// renderFilename renders a filename template for a recording. Fields are
+// sanitized so they can't introduce path separators.
+func renderFilename(tmpl *template.Template, d *veo.RecordingDetails) (string, error) {
+	start := d.Start.Local()
+	data := filenameData{
+		Date:     start.Format("2006-01-02"),
+		Time:     start.Format("1504"),
+		Year:     start.Format("2006"),
+		Title:    sanitizeFilename(d.Title),
+		Opponent: sanitizeFilename(firstNonEmpty(d.OpponentTeamName, d.OpponentClubName)),
+		Type:     sanitizeFilename(d.Type),
+		Slug:     sanitizeFilename(d.Slug),
+		ID:       sanitizeFilename(d.Identifier),
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render filename: %w", err)
+	}
+
+	name := strings.TrimSpace(buf.String())
+	if name == "" {
+		return "", fmt.Errorf("filename template produced an empty name")
+	}
+
+	return name, nil
+}
+
+// sanitizeFilename replaces characters that aren't safe in filenames
+func sanitizeFilename(s string) string {
+	return strings.TrimSpace(strings.Map(func(r rune) rune {
+		if r < 32 || strings.ContainsRune(`/\:*?"<>|`, r) {
+			return '-'
+		}
+		return r
+	}, s))
+}
@@ -0,0 +1,256 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/justincampbell/veo"
+	"github.com/justincampbell/veo/internal/download"
+)
+
+func TestRenderFilename(t *testing.T) {
+	details := &veo.RecordingDetails{
+		Identifier:       "id-1",
+		Slug:             "20251116-match",
+		Title:            "Match: Us/Them",
+		Start:            time.Date(2025, 11, 16, 12, 0, 0, 0, time.Local),
+		OpponentClubName: "Opponent Club",
+	}
+
+	tests := []struct {
+		name     string
+		template string
+		expected string
+	}{
+		{
+			name:     "default",
+			template: defaultFilenameTemplate,
+			expected: "2025-11-16 Match- Us-Them.mp4",
+		},
+		{
+			name:     "directories and opponent",
+			template: "{{.Year}}/{{.Date}} vs {{.Opponent}}.mp4",
+			expected: "2025/2025-11-16 vs Opponent Club.mp4",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl := template.Must(template.New("name").Parse(tt.template))
+			result, err := renderFilename(tmpl, details)
+			if err != nil {
+				t.Fatalf("renderFilename failed: %v", err)
+			}
+			if result != tt.expected {
+				t.Errorf("renderFilename = %q, expected %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFindVideo(t *testing.T) {
+	videos := []veo.Video{
+		{ID: "v1", Kind: "panorama", URL: "pano.mp4"},
+		{ID: "v2", Kind: "follow-cam", URL: "follow.mp4"},
+	}
+
+	if v, err := findVideo(videos, "Follow-Cam"); err != nil || v.URL != "follow.mp4" {
+		t.Errorf("expected follow-cam video by kind, got %v, %v", v, err)
+	}
+	if v, err := findVideo(videos, "v1"); err != nil || v.URL != "pano.mp4" {
+		t.Errorf("expected panorama video by ID, got %v, %v", v, err)
+	}
+	if _, err := findVideo(videos, "reel"); err == nil {
+		t.Error("expected error for unknown video")
+	}
+}
+
+func TestParseByteSize(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected int64
+		wantErr  bool
+	}{
+		{input: "1000", expected: 1000},
+		{input: "500K", expected: 500 * 1024},
+		{input: "5M", expected: 5 * 1024 * 1024},
+		{input: "1.5MiB", expected: 1536 * 1024},
+		{input: "2g", expected: 2 * 1024 * 1024 * 1024},
+		{input: "fast", wantErr: true},
+		{input: "0", wantErr: true},
+		{input: "0.5", wantErr: true},
+		{input: "0.0001K", wantErr: true},
+		{input: "-1M", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := parseByteSize(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("parseByteSize(%q) expected error", tt.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseByteSize(%q) failed: %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("parseByteSize(%q) = %d, expected %d", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRunBulkDownload(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/clubs/test-club/recordings/":
+			w.Write([]byte(`[
+				{"identifier": "id1", "title": "Match 1", "start": "2025-11-01T12:00:00Z"},
+				{"identifier": "id2", "title": "Match 2", "start": "2025-11-08T12:00:00Z"},
+				{"identifier": "id3", "title": "Match 3", "start": "2025-11-15T12:00:00Z"}
+			]`))
+		case strings.HasPrefix(r.URL.Path, "/matches/"):
+			id := strings.Trim(strings.TrimPrefix(r.URL.Path, "/matches/"), "/")
+			reel := ""
+			if id != "id3" {
+				reel = server.URL + "/files/" + id + ".mp4"
+			}
+			fmt.Fprintf(w, `{"identifier": %q, "slug": %q, "title": "Match", "reel_url": %q}`, id, id, reel)
+		case strings.HasPrefix(r.URL.Path, "/files/"):
+			w.Write([]byte("video data for " + r.URL.Path))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	job := &downloadJob{
+		client: veo.NewClient(veo.WithBaseURL(server.URL), veo.WithAuthToken("test-token")),
+		d:      download.New(),
+		tmpl:   template.Must(template.New("name").Parse("{{.ID}}.mp4")),
+		dir:    dir,
+	}
+
+	since, _, err := parseDateRange("2025-11-05", "")
+	if err != nil {
+		t.Fatalf("parseDateRange failed: %v", err)
+	}
+
+	// id1 is before since, and id3 has no reel, so the run reports one failure
+	err = runBulkDownload(context.Background(), job, "test-club", &recordingFilter{since: since}, 2)
+	if err == nil || !strings.Contains(err.Error(), "1 of 2") {
+		t.Errorf("expected 1 of 2 downloads to fail, got %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "id1.mp4")); err == nil {
+		t.Error("expected id1 to be filtered out")
+	}
+	data, err := os.ReadFile(filepath.Join(dir, "id2.mp4"))
+	if err != nil {
+		t.Fatalf("expected id2 to be downloaded: %v", err)
+	}
+	if string(data) != "video data for /files/id2.mp4" {
+		t.Errorf("unexpected content for id2: %q", data)
+	}
+}
+
+func TestRunBulkDownloadSameName(t *testing.T) {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/clubs/test-club/recordings/":
+			w.Write([]byte(`[
+				{"identifier": "am", "title": "Training", "start": "2025-11-01T09:00:00Z"},
+				{"identifier": "pm", "title": "Training", "start": "2025-11-01T12:00:00Z"},
+				{"identifier": "match", "title": "Match", "start": "2025-11-01T12:00:00Z"}
+			]`))
+		case strings.HasPrefix(r.URL.Path, "/matches/"):
+			id := strings.Trim(strings.TrimPrefix(r.URL.Path, "/matches/"), "/")
+			title, start := "Training", "2025-11-01T09:00:00Z"
+			if id == "match" {
+				title = "Match"
+			}
+			if id != "am" {
+				start = "2025-11-01T12:00:00Z"
+			}
+			fmt.Fprintf(w, `{"identifier": %q, "slug": %q, "title": %q, "start": %q, "reel_url": %q}`,
+				id, id, title, start, server.URL+"/files/"+id+".mp4")
+		case strings.HasPrefix(r.URL.Path, "/files/"):
+			w.Write([]byte("video data for " + r.URL.Path))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	job := &downloadJob{
+		client: veo.NewClient(veo.WithBaseURL(server.URL), veo.WithAuthToken("test-token")),
+		d:      download.New(),
+		tmpl:   template.Must(template.New("name").Parse(defaultFilenameTemplate)),
+		dir:    dir,
+	}
+
+	if err := runBulkDownload(context.Background(), job, "test-club", &recordingFilter{}, 3); err != nil {
+		t.Fatalf("runBulkDownload failed: %v", err)
+	}
+
+	// The two trainings share a date and title, so get their IDs added
+	date := time.Date(2025, 11, 1, 12, 0, 0, 0, time.UTC).Local().Format("2006-01-02")
+	expected := map[string]string{
+		date + " Training (am).mp4": "am",
+		date + " Training (pm).mp4": "pm",
+		date + " Match.mp4":         "match",
+	}
+	for name, id := range expected {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Errorf("expected %s to be downloaded: %v", name, err)
+			continue
+		}
+		if string(data) != "video data for /files/"+id+".mp4" {
+			t.Errorf("unexpected content for %s: %q", name, data)
+		}
+	}
+}
+
+func TestUniqueFilenames(t *testing.T) {
+	items := []bulkItem{
+		{recording: veo.Recording{Identifier: "a"}, name: "2025-11-01 Training.mp4"},
+		{recording: veo.Recording{Identifier: "b"}, name: "2025-11-01 training.mp4"},
+		{recording: veo.Recording{Identifier: "c"}, name: "2025-11-01 Match.mp4"},
+		{recording: veo.Recording{Identifier: "d"}, name: "2025-11-01 Match.mp4", err: fmt.Errorf("no reel")},
+	}
+	if err := uniqueFilenames(items); err != nil {
+		t.Fatalf("uniqueFilenames failed: %v", err)
+	}
+
+	expected := []string{"2025-11-01 Training (a).mp4", "2025-11-01 training (b).mp4", "2025-11-01 Match.mp4", "2025-11-01 Match.mp4"}
+	for i, item := range items {
+		if item.name != expected[i] {
+			t.Errorf("items[%d].name = %q, expected %q", i, item.name, expected[i])
+		}
+	}
+
+	// Names that still clash once the IDs are added are an error
+	items = []bulkItem{
+		{recording: veo.Recording{Identifier: "a"}, name: "x.mp4"},
+		{recording: veo.Recording{Identifier: "b"}, name: "x.mp4"},
+		{recording: veo.Recording{Identifier: "c"}, name: "x (a).mp4"},
+	}
+	if err := uniqueFilenames(items); err == nil {
+		t.Error("expected an error for names that still clash")
+	}
+}
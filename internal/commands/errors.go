@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/justincampbell/veo"
+)
+
+// Exit codes, so scripts can tell failures apart
+const (
+	ExitError        = 1 // Any other failure
+	ExitUnauthorized = 3 // Token missing, invalid or expired
+	ExitForbidden    = 4 // Token lacks permission
+	ExitNotFound     = 5 // Recording or club not found
+	ExitRateLimited  = 6 // Too many requests
+	ExitServerError  = 7 // Veo had a server error
+)
+
+// notLoggedInError is returned when no token is configured
+type notLoggedInError struct {
+	profile string
+}
+
+func (e *notLoggedInError) Error() string {
+	return fmt.Sprintf("not logged in: run 'veo login' or set VEO_TOKEN (profile %q)", e.profile)
+}
+
+// ExitCode returns the process exit code for an error returned by a command
+func ExitCode(err error) int {
+	var notLoggedIn *notLoggedInError
+	switch {
+	case err == nil:
+		return 0
+	case errors.As(err, &notLoggedIn), veo.IsUnauthorized(err):
+		return ExitUnauthorized
+	case veo.IsForbidden(err):
+		return ExitForbidden
+	case veo.IsNotFound(err):
+		return ExitNotFound
+	case veo.IsRateLimited(err):
+		return ExitRateLimited
+	case veo.IsServerError(err):
+		return ExitServerError
+	}
+	return ExitError
+}
+
+// ErrorMessage returns the message to show for an error returned by a
+// command, with a hint on how to fix common API errors
+func ErrorMessage(err error) string {
+	hint := ""
+	switch {
+	case veo.IsUnauthorized(err):
+		hint = "Your token is invalid or has expired. Copy a new one from the browser and run 'veo login'."
+	case veo.IsForbidden(err):
+		hint = "Your account doesn't have permission for this. Check the club and profile you are using."
+	case veo.IsNotFound(err):
+		hint = "Not found. Check the recording ID or club slug (see 'veo list')."
+	case veo.IsRateLimited(err):
+		hint = "Veo is rate limiting requests. Wait a moment, or slow down with --rate."
+	case veo.IsServerError(err):
+		hint = "Veo had a server error. Try again later."
+	}
+
+	if hint == "" {
+		return err.Error()
+	}
+	return err.Error() + "\n" + hint
+}
@@ -0,0 +1,73 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/justincampbell/veo"
+)
+
+func TestExitCodeAndMessage(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		code     int
+		contains string
+	}{
+		{
+			name:     "unauthorized",
+			err:      fmt.Errorf("failed to list recordings: %w", &veo.APIError{StatusCode: 401}),
+			code:     ExitUnauthorized,
+			contains: "veo login",
+		},
+		{
+			name:     "no token",
+			err:      (&settings{Profile: "home"}).requireToken(),
+			code:     ExitUnauthorized,
+			contains: `not logged in: run 'veo login' or set VEO_TOKEN (profile "home")`,
+		},
+		{
+			name:     "no token, wrapped",
+			err:      fmt.Errorf("sync failed: %w", (&settings{}).requireToken()),
+			code:     ExitUnauthorized,
+			contains: "not logged in",
+		},
+		{
+			name:     "not found",
+			err:      fmt.Errorf("failed to get recording: %w", &veo.APIError{StatusCode: 404}),
+			code:     ExitNotFound,
+			contains: "veo list",
+		},
+		{
+			name:     "rate limited",
+			err:      &veo.APIError{StatusCode: 429},
+			code:     ExitRateLimited,
+			contains: "--rate",
+		},
+		{
+			name:     "server error",
+			err:      &veo.APIError{StatusCode: 503},
+			code:     ExitServerError,
+			contains: "server error",
+		},
+		{
+			name:     "other error",
+			err:      errors.New("something broke"),
+			code:     ExitError,
+			contains: "something broke",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := ExitCode(tt.err); code != tt.code {
+				t.Errorf("ExitCode = %d, expected %d", code, tt.code)
+			}
+			if msg := ErrorMessage(tt.err); !strings.Contains(msg, tt.contains) {
+				t.Errorf("ErrorMessage = %q, expected it to contain %q", msg, tt.contains)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/justincampbell/veo"
+)
+
+// recordingFilter selects recordings client-side. Zero values match
+// everything.
+type recordingFilter struct {
+	since, until   time.Time // Start within [since, until)
+	title          *regexp.Regexp
+	matchType      string
+	minDuration    time.Duration
+	maxDuration    time.Duration
+	camera         string
+	team           string
+	accessibleOnly bool
+}
+
+// active reports whether the filter excludes anything
+func (f *recordingFilter) active() bool {
+	return *f != recordingFilter{}
+}
+
+// match reports whether r passes the filter
+func (f *recordingFilter) match(r veo.Recording) bool {
+	duration := time.Duration(r.Duration) * time.Second
+
+	switch {
+	case !f.since.IsZero() && r.Start.Before(f.since):
+		return false
+	case !f.until.IsZero() && !r.Start.Before(f.until):
+		return false
+	case f.title != nil && !f.title.MatchString(r.Title):
+		return false
+	case f.matchType != "" && r.Type != f.matchType:
+		return false
+	case f.minDuration > 0 && duration < f.minDuration:
+		return false
+	case f.maxDuration > 0 && duration > f.maxDuration:
+		return false
+	case f.camera != "" && !strings.EqualFold(r.Camera, f.camera):
+		return false
+	case f.team != "" && !strings.EqualFold(r.Team, f.team):
+		return false
+	case f.accessibleOnly && !r.IsAccessible:
+		return false
+	}
+	return true
+}
+
+// listMatching streams a club's recordings from opts.Page, keeping those that
+// match filter. It stops after limit matches (0 means no limit). There is no
+// early stop for the since date: a recording's start can be edited (veo
+// update --start) to any time, so the listing order says nothing about it.
+func listMatching(ctx context.Context, client *veo.Client, clubSlug string, opts *veo.ListRecordingsOptions, filter *recordingFilter, limit int) (*veo.ListRecordingsResult, error) {
+	result := &veo.ListRecordingsResult{}
+	for r, err := range client.Recordings(ctx, clubSlug, opts) {
+		if err != nil {
+			return nil, err
+		}
+		if !filter.match(r) {
+			continue
+		}
+		result.Recordings = append(result.Recordings, r)
+		if len(result.Recordings) == limit {
+			break
+		}
+	}
+	return result, nil
+}
+
+// compileTitlePattern compiles a case-insensitive --title pattern
+func compileTitlePattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+	re, err := regexp.Compile("(?i)" + pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --title pattern: %w", err)
+	}
+	return re, nil
+}
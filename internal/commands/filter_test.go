@@ -0,0 +1,96 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/justincampbell/veo"
+)
+
+func TestRecordingFilterMatch(t *testing.T) {
+	r := veo.Recording{
+		Title:        "Match - Rivals FC",
+		Type:         "match",
+		Duration:     3600,
+		Camera:       "vc3-abc",
+		Team:         "u11-girls",
+		IsAccessible: true,
+		Start:        time.Date(2025, 11, 16, 12, 0, 0, 0, time.Local),
+	}
+
+	tests := []struct {
+		name     string
+		filter   recordingFilter
+		expected bool
+	}{
+		{name: "empty", filter: recordingFilter{}, expected: true},
+		{name: "since before", filter: recordingFilter{since: time.Date(2025, 11, 16, 0, 0, 0, 0, time.Local)}, expected: true},
+		{name: "since after", filter: recordingFilter{since: time.Date(2025, 11, 17, 0, 0, 0, 0, time.Local)}, expected: false},
+		{name: "until", filter: recordingFilter{until: time.Date(2025, 11, 16, 0, 0, 0, 0, time.Local)}, expected: false},
+		{name: "title", filter: recordingFilter{title: regexp.MustCompile("(?i)rivals")}, expected: true},
+		{name: "title mismatch", filter: recordingFilter{title: regexp.MustCompile("training")}, expected: false},
+		{name: "type", filter: recordingFilter{matchType: "training"}, expected: false},
+		{name: "min duration", filter: recordingFilter{minDuration: 90 * time.Minute}, expected: false},
+		{name: "max duration", filter: recordingFilter{maxDuration: time.Hour}, expected: true},
+		{name: "camera", filter: recordingFilter{camera: "VC3-ABC"}, expected: true},
+		{name: "team", filter: recordingFilter{team: "u12-boys"}, expected: false},
+		{name: "accessible", filter: recordingFilter{accessibleOnly: true}, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.match(r); got != tt.expected {
+				t.Errorf("match = %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestListMatchingSinceWithEditedStart(t *testing.T) {
+	// Ten pages of one recording per week, newest first by creation
+	newest := time.Date(2025, 11, 16, 12, 0, 0, 0, time.Local)
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		page = max(page, 1)
+		if page < 10 {
+			w.Header().Set("Link", fmt.Sprintf(`</clubs/test-club/recordings/?page=%d>; rel="next"`, page+1))
+		}
+		created := newest.AddDate(0, 0, -7*(page-1)).Add(2 * time.Hour)
+		start := created.Add(-2 * time.Hour)
+		if page == 8 {
+			// Uploaded long ago, but its start was later moved with
+			// veo update --start, so it starts after it was created
+			start = newest.AddDate(0, 0, -1)
+		}
+		fmt.Fprintf(w, `[{"identifier": "week%d", "start": %q, "created": %q}]`,
+			page, start.Format(time.RFC3339), created.Format(time.RFC3339))
+	}))
+	defer server.Close()
+
+	client := veo.NewClient(veo.WithBaseURL(server.URL), veo.WithAuthToken("test-token"))
+	filter := &recordingFilter{since: newest.AddDate(0, 0, -15)}
+	result, err := listMatching(context.Background(), client, "test-club", &veo.ListRecordingsOptions{}, filter, 0)
+	if err != nil {
+		t.Fatalf("listMatching failed: %v", err)
+	}
+
+	var ids []string
+	for _, r := range result.Recordings {
+		ids = append(ids, r.Identifier)
+	}
+	if strings.Join(ids, ",") != "week1,week2,week3,week8" {
+		t.Errorf("expected week1-3 and the moved week8, got %v", ids)
+	}
+	if requests != 10 {
+		t.Errorf("expected all 10 pages to be searched, got %d requests", requests)
+	}
+}
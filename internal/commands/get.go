@@ -1,101 +1,75 @@
 package commands
 
 import (
-	"encoding/json"
 	"fmt"
 	"os"
 
-	"github.com/justincampbell/veo/internal/api"
+	"github.com/justincampbell/veo"
 	"github.com/spf13/cobra"
 )
 
 // NewGetCmd creates the get command
 func NewGetCmd() *cobra.Command {
-	var jsonOutput bool
+	var output *outputFlags
 	var clubSlug string
 
 	cmd := &cobra.Command{
-		Use:   "get <recording-id|latest>",
+		Use:   "get [recording-id|latest]",
 		Short: "Get details for a specific recording",
 		Long:  `Get detailed information about a specific recording/match.
 
-Use "latest" to get the most recent recording.`,
-		Args: cobra.ExactArgs(1),
+Use "latest" to get the most recent recording, or "latest~1" for the one
+before it. See "veo help selectors" for other ways to pick a recording.
+Without a recording, or if several match, a picker is shown on a terminal.`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			recordingID := args[0]
-
-			// Get auth token from environment
-			token := os.Getenv("VEO_TOKEN")
-			if token == "" {
-				return fmt.Errorf("VEO_TOKEN environment variable is required")
+			s, err := loadSettings(cmd, clubSlug)
+			if err != nil {
+				return err
+			}
+			format, err := output.resolve(cmd, s)
+			if err != nil {
+				return err
+			}
+			if err := s.requireToken(); err != nil {
+				return err
 			}
 
 			// Create API client
-			client := api.NewClient(api.WithAuthToken(token))
-
-			// Handle "latest" special case
-			if recordingID == "latest" {
-				// Get club slug from flag or environment variable
-				if clubSlug == "" {
-					clubSlug = os.Getenv("VEO_CLUB")
-				}
-				if clubSlug == "" {
-					return fmt.Errorf("--club flag or VEO_CLUB environment variable is required for 'latest'")
-				}
-
-				// List recordings to get the latest one
-				opts := &api.ListRecordingsOptions{Page: 1}
-				result, err := client.ListRecordings(clubSlug, opts)
-				if err != nil {
-					return fmt.Errorf("failed to list recordings: %w", err)
-				}
-
-				if len(result.Recordings) == 0 {
-					return fmt.Errorf("no recordings found")
-				}
-
-				// Use the first recording (most recent)
-				recordingID = result.Recordings[0].Identifier
-			}
+			client := s.newClient()
 
-			// Get recording details
-			details, err := client.GetRecording(recordingID)
+			// Resolve "latest", dates, slug prefixes and titles, and get the
+			// recording's details
+			details, err := resolveRecording(cmd, client, s, args)
 			if err != nil {
-				return fmt.Errorf("failed to get recording: %w", err)
+				return err
 			}
 
 			// Get periods for kickoff timestamp
-			periods, err := client.GetPeriods(details.Slug)
+			periods, err := client.GetPeriods(cmd.Context(), details.Slug)
 			if err != nil {
 				// Don't fail if periods aren't available, just log
 				fmt.Fprintf(os.Stderr, "Warning: could not fetch periods: %v\n", err)
 			}
 
-			// Output as JSON if requested
-			if jsonOutput {
-				encoder := json.NewEncoder(os.Stdout)
-				encoder.SetIndent("", "  ")
-				if err := encoder.Encode(details); err != nil {
-					return fmt.Errorf("failed to encode JSON: %w", err)
-				}
+			// Print human-readable format
+			if format == formatTable {
+				printRecordingDetails(details, periods)
 				return nil
 			}
 
-			// Print human-readable format
-			printRecordingDetails(details, periods)
-
-			return nil
+			return output.write(cmd.Context(), cmd.OutOrStdout(), client, format, details, detailsTable(details, periods))
 		},
 	}
 
-	cmd.Flags().BoolVarP(&jsonOutput, "json", "j", false, "Output as JSON")
-	cmd.Flags().StringVarP(&clubSlug, "club", "c", "", "Club slug (required for 'latest', or set VEO_CLUB environment variable)")
+	output = addOutputFlags(cmd)
+	cmd.Flags().StringVarP(&clubSlug, "club", "c", "", "Club slug (required to select recordings by other than ID, or set VEO_CLUB or a config profile)")
 
 	return cmd
 }
 
 // printRecordingDetails prints recording details in a human-readable format
-func printRecordingDetails(d *api.RecordingDetails, periods []api.Period) {
+func printRecordingDetails(d *veo.RecordingDetails, periods []veo.Period) {
 	fmt.Printf("ID:          %s\n", d.Identifier)
 	fmt.Printf("Title:       %s\n", d.Title)
 	fmt.Printf("Type:        %s\n", d.Type)
@@ -140,56 +114,19 @@ func printRecordingDetails(d *api.RecordingDetails, periods []api.Period) {
 	}
 
 	// Score if available
-	if d.Info != nil {
-		if stats, ok := d.Info["stats"].(map[string]interface{}); ok {
-			// Try score_aggregated first (actual final score), fall back to score
-			var ownScore, oppScore float64
-			var hasScore bool
-
-			if scoreAgg, ok := stats["score_aggregated"].(map[string]interface{}); ok {
-				if own, ok := scoreAgg["own"].(float64); ok {
-					ownScore = own
-					hasScore = true
-				}
-				if opp, ok := scoreAgg["opponent"].(float64); ok {
-					oppScore = opp
-					hasScore = true
-				}
-			} else if score, ok := stats["score"].(map[string]interface{}); ok {
-				if own, ok := score["own"].(float64); ok {
-					ownScore = own
-					hasScore = true
-				}
-				if opp, ok := score["opponent"].(float64); ok {
-					oppScore = opp
-					hasScore = true
-				}
-			}
-
-			if hasScore {
-				fmt.Printf("Score:       %.0f-%.0f\n", ownScore, oppScore)
-			}
+	if own, opponent, ok := recordingScore(d); ok {
+		fmt.Printf("Score:       %d-%d\n", own, opponent)
+	}
 
-			// Age group if available
-			if ageGroup, ok := d.Info["age_group"].(string); ok && ageGroup != "" {
-				fmt.Printf("Age Group:   %s\n", ageGroup)
-			}
-		}
+	// Age group if available
+	if ageGroup := recordingAgeGroup(d); ageGroup != "" {
+		fmt.Printf("Age Group:   %s\n", ageGroup)
 	}
 
 	fmt.Printf("\nSlug:        %s\n", d.Slug)
 
-	// Share URL with kickoff timestamp
-	if len(periods) > 0 && len(periods[0].Timeframe) > 0 {
-		kickoffSeconds := periods[0].Timeframe[0]
-		kickoffTime := formatTimestamp(kickoffSeconds)
-		shareURL := fmt.Sprintf("https://app.veo.co/matches/%s/#t=%s", d.Slug, kickoffTime)
-		fmt.Printf("\nShare URL:   %s\n", shareURL)
-	} else {
-		// Fallback to share URL without timestamp
-		shareURL := fmt.Sprintf("https://app.veo.co/matches/%s/", d.Slug)
-		fmt.Printf("\nShare URL:   %s\n", shareURL)
-	}
+	// Share URL, with kickoff timestamp if available
+	fmt.Printf("\nShare URL:   %s\n", shareURL(d.Slug, periods))
 
 	// Highlights URL
 	if d.ReelURL != "" {
@@ -197,6 +134,60 @@ func printRecordingDetails(d *api.RecordingDetails, periods []api.Period) {
 	}
 }
 
+// detailsTable renders recording details as a single table row
+func detailsTable(d *veo.RecordingDetails, periods []veo.Period) *table {
+	score := ""
+	if own, opponent, ok := recordingScore(d); ok {
+		score = fmt.Sprintf("%d-%d", own, opponent)
+	}
+
+	return &table{
+		headers: []string{"ID", "SLUG", "TITLE", "TYPE", "START", "DURATION", "HOME/AWAY", "OPPONENT", "SCORE", "SHARE URL"},
+		rows: [][]string{{
+			d.Identifier,
+			d.Slug,
+			d.Title,
+			d.Type,
+			formatLocalTime(d.Start),
+			formatDuration(d.Duration),
+			d.OwnTeamHomeOrAway,
+			d.OpponentTeamName,
+			score,
+			shareURL(d.Slug, periods),
+		}},
+	}
+}
+
+// recordingScore returns the match score, preferring score_aggregated
+// (actual final score) over score
+func recordingScore(d *veo.RecordingDetails) (own, opponent int, ok bool) {
+	stats, _ := d.Info["stats"].(map[string]interface{})
+	score, found := stats["score_aggregated"].(map[string]interface{})
+	if !found {
+		score, _ = stats["score"].(map[string]interface{})
+	}
+
+	ownScore, ownOK := score["own"].(float64)
+	oppScore, oppOK := score["opponent"].(float64)
+	return int(ownScore), int(oppScore), ownOK || oppOK
+}
+
+// recordingAgeGroup returns the age group from the match info, if set
+func recordingAgeGroup(d *veo.RecordingDetails) string {
+	ageGroup, _ := d.Info["age_group"].(string)
+	return ageGroup
+}
+
+// shareURL returns the app URL of a match, starting at kickoff if the
+// periods are known
+func shareURL(slug string, periods []veo.Period) string {
+	if len(periods) > 0 && len(periods[0].Timeframe) > 0 {
+		kickoffTime := formatTimestamp(periods[0].Timeframe[0])
+		return fmt.Sprintf("https://app.veo.co/matches/%s/#t=%s", slug, kickoffTime)
+	}
+	return fmt.Sprintf("https://app.veo.co/matches/%s/", slug)
+}
+
 // formatTimestamp converts seconds to MM:SS format for URL timestamps
 func formatTimestamp(seconds int) string {
 	minutes := seconds / 60
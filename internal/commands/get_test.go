@@ -9,8 +9,8 @@ func TestGetCmdUsage(t *testing.T) {
 	cmd := NewGetCmd()
 
 	// Verify command basic properties
-	if cmd.Use != "get <recording-id|latest>" {
-		t.Errorf("expected Use to be 'get <recording-id|latest>', got %q", cmd.Use)
+	if cmd.Use != "get [recording-id|latest]" {
+		t.Errorf("expected Use to be 'get [recording-id|latest]', got %q", cmd.Use)
 	}
 
 	if !strings.Contains(cmd.Long, "latest") {
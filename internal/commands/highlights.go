@@ -0,0 +1,136 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/justincampbell/veo"
+	"github.com/spf13/cobra"
+)
+
+// NewHighlightsCmd creates the highlights command
+func NewHighlightsCmd() *cobra.Command {
+	var clubSlug string
+	var output *outputFlags
+	var tags []string
+	var aiOnly, manualOnly bool
+
+	cmd := &cobra.Command{
+		Use:   "highlights [recording-id|latest]",
+		Short: "List highlights for a recording",
+		Long: `List the highlight clips of a recording, including AI-generated ones.
+
+Use --tag to show only clips with a tag (e.g. goal), and --ai or --manual to
+show only AI-generated or manually created clips.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if aiOnly && manualOnly {
+				return fmt.Errorf("--ai and --manual cannot be used together")
+			}
+
+			s, err := loadSettings(cmd, clubSlug)
+			if err != nil {
+				return err
+			}
+			format, err := output.resolve(cmd, s)
+			if err != nil {
+				return err
+			}
+			if err := s.requireToken(); err != nil {
+				return err
+			}
+
+			// Create API client
+			client := s.newClient()
+
+			details, err := resolveRecording(cmd, client, s, args)
+			if err != nil {
+				return err
+			}
+
+			// Manual-only clips don't need AI highlights from the API
+			opts := &veo.ListHighlightsOptions{IncludeAI: !manualOnly}
+			highlights, err := client.ListHighlights(cmd.Context(), details.Slug, opts)
+			if err != nil {
+				return fmt.Errorf("failed to list highlights: %w", err)
+			}
+
+			highlights = filterHighlights(highlights, tags, aiOnly, manualOnly)
+
+			if err := output.write(cmd.Context(), cmd.OutOrStdout(), client, format, highlights, highlightsTable(highlights)); err != nil {
+				return err
+			}
+			if format != formatTable {
+				return nil
+			}
+
+			fmt.Fprintf(os.Stderr, "\nTotal: %d highlights\n", len(highlights))
+
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&clubSlug, "club", "c", "", "Club slug (required to select recordings by other than ID, or set VEO_CLUB or a config profile)")
+	output = addOutputFlags(cmd)
+	cmd.Flags().StringSliceVarP(&tags, "tag", "t", nil, "Only show highlights with this tag (repeatable)")
+	cmd.Flags().BoolVar(&aiOnly, "ai", false, "Only show AI-generated highlights")
+	cmd.Flags().BoolVar(&manualOnly, "manual", false, "Only show manually created highlights")
+
+	return cmd
+}
+
+// filterHighlights returns the highlights that have any of tags (if given)
+// and match the AI/manual selection
+func filterHighlights(highlights []veo.Highlight, tags []string, aiOnly, manualOnly bool) []veo.Highlight {
+	// Not nil, so JSON output is [] when nothing matches
+	filtered := []veo.Highlight{}
+	for _, h := range highlights {
+		if aiOnly && !h.IsAIGenerated {
+			continue
+		}
+		if manualOnly && h.IsAIGenerated {
+			continue
+		}
+		if len(tags) > 0 && !hasAnyTag(h.Tags, tags) {
+			continue
+		}
+		filtered = append(filtered, h)
+	}
+	return filtered
+}
+
+// hasAnyTag reports whether any of want appears in tags, ignoring case
+func hasAnyTag(tags, want []string) bool {
+	for _, t := range tags {
+		for _, w := range want {
+			if strings.EqualFold(t, w) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// highlightSource describes who created a highlight
+func highlightSource(h veo.Highlight) string {
+	if h.IsAIGenerated {
+		return "ai"
+	}
+	return "manual"
+}
+
+// highlightsTable renders highlights as a table
+func highlightsTable(highlights []veo.Highlight) *table {
+	t := &table{headers: []string{"ID", "START", "DURATION", "SOURCE", "TAGS"}}
+	for _, h := range highlights {
+		t.rows = append(t.rows, []string{
+			h.ID,
+			formatTimestamp(int(h.Start)),
+			formatDuration(int(h.Duration)),
+			highlightSource(h),
+			strings.Join(h.Tags, ","),
+		})
+	}
+	return t
+}
@@ -0,0 +1,73 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/justincampbell/veo"
+)
+
+func TestFilterHighlights(t *testing.T) {
+	highlights := []veo.Highlight{
+		{ID: "goal-ai", IsAIGenerated: true, Tags: []string{"goal"}},
+		{ID: "goal-manual", Tags: []string{"Goal", "penalty"}},
+		{ID: "save-manual", Tags: []string{"save"}},
+		{ID: "untagged-ai", IsAIGenerated: true},
+	}
+
+	tests := []struct {
+		name       string
+		tags       []string
+		aiOnly     bool
+		manualOnly bool
+		expected   []string
+	}{
+		{
+			name:     "no filters",
+			expected: []string{"goal-ai", "goal-manual", "save-manual", "untagged-ai"},
+		},
+		{
+			name:     "tag is case insensitive",
+			tags:     []string{"goal"},
+			expected: []string{"goal-ai", "goal-manual"},
+		},
+		{
+			name:     "any of several tags",
+			tags:     []string{"penalty", "save"},
+			expected: []string{"goal-manual", "save-manual"},
+		},
+		{
+			name:     "AI only",
+			aiOnly:   true,
+			expected: []string{"goal-ai", "untagged-ai"},
+		},
+		{
+			name:       "manual goals",
+			tags:       []string{"goal"},
+			manualOnly: true,
+			expected:   []string{"goal-manual"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := filterHighlights(highlights, tt.tags, tt.aiOnly, tt.manualOnly)
+			if len(result) != len(tt.expected) {
+				t.Fatalf("expected %d highlights, got %d", len(tt.expected), len(result))
+			}
+			for i, h := range result {
+				if h.ID != tt.expected[i] {
+					t.Errorf("result[%d] = %q, expected %q", i, h.ID, tt.expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestFilterHighlightsNoMatchesIsEmpty(t *testing.T) {
+	highlights := []veo.Highlight{{ID: "save-manual", Tags: []string{"save"}}}
+
+	result := filterHighlights(highlights, []string{"goal"}, false, false)
+	if result == nil || len(result) != 0 {
+		t.Errorf("expected an empty, non-nil slice so JSON output is [], got %#v", result)
+	}
+}
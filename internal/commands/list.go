@@ -1,84 +1,126 @@
 package commands
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
 	"os"
-	"text/tabwriter"
+	"strings"
 	"time"
 
-	"github.com/justincampbell/veo/internal/api"
+	"github.com/justincampbell/veo"
 	"github.com/spf13/cobra"
 	"golang.org/x/term"
 )
 
+// defaultPageWorkers is the number of pages fetched at once when listing a
+// whole library
+const defaultPageWorkers = 4
+
 // NewListCmd creates the list command
 func NewListCmd() *cobra.Command {
 	var clubSlug string
 	var page int
 	var all bool
-	var jsonOutput bool
+	var limit int
+	var workers int
+	var fields []string
+	var filter string
+	var since, until string
+	var titlePattern string
+	var matchType string
+	var minDuration, maxDuration time.Duration
+	var camera, team string
+	var accessibleOnly bool
+	var sortKey string
+	var reverse bool
+	var columnNames []string
+	var output *outputFlags
 
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List recordings",
-		Long:  `List all recordings/matches from your Veo camera.`,
+		Long: `List all recordings/matches from your Veo camera.
+
+The --since, --until, --title, --type, --min-duration, --max-duration,
+--camera, --team and --accessible-only flags filter recordings client-side.
+When any of them are given, all pages are searched (until --limit matches
+are found). Dates may be YYYY-MM-DD, today,
+yesterday, relative (7d, 2w, 3m, 1y), this-season or last-season (seasons
+start on August 1).`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			// Get auth token from environment
-			token := os.Getenv("VEO_TOKEN")
-			if token == "" {
-				return fmt.Errorf("VEO_TOKEN environment variable is required")
+			if filter != veo.FilterOwn {
+				fmt.Fprintf(os.Stderr, "Warning: filter %q is untested; only %q is known to work\n", filter, veo.FilterOwn)
+			}
+			if matchType != "" && !contains(validMatchTypes, matchType) {
+				return fmt.Errorf("invalid type %q (valid: %v)", matchType, validMatchTypes)
+			}
+
+			columns, err := parseListColumns(columnNames)
+			if err != nil {
+				return err
+			}
+			if _, ok := listSortKeys[sortKey]; sortKey != "" && !ok {
+				return fmt.Errorf("invalid sort %q (valid: start, created, duration, title)", sortKey)
+			}
+
+			sinceDate, untilDate, err := parseDateRange(since, until)
+			if err != nil {
+				return err
+			}
+			title, err := compileTitlePattern(titlePattern)
+			if err != nil {
+				return err
+			}
+			match := &recordingFilter{
+				since:          sinceDate,
+				until:          untilDate,
+				title:          title,
+				matchType:      matchType,
+				minDuration:    minDuration,
+				maxDuration:    maxDuration,
+				camera:         camera,
+				team:           team,
+				accessibleOnly: accessibleOnly,
 			}
 
-			// Get club slug from flag or environment variable
-			if clubSlug == "" {
-				clubSlug = os.Getenv("VEO_CLUB")
+			s, err := loadSettings(cmd, clubSlug)
+			if err != nil {
+				return err
 			}
-			if clubSlug == "" {
-				return fmt.Errorf("--club flag or VEO_CLUB environment variable is required")
+			format, err := output.resolve(cmd, s)
+			if err != nil {
+				return err
+			}
+			if err := s.requireToken(); err != nil {
+				return err
+			}
+			if err := s.requireClub(); err != nil {
+				return err
 			}
 
 			// Create API client
-			client := api.NewClient(api.WithAuthToken(token))
+			client := s.newClient()
 
 			// List recordings with pagination options
-			opts := &api.ListRecordingsOptions{
-				Page:     page,
-				FetchAll: all,
+			opts := &veo.ListRecordingsOptions{
+				Page:        page,
+				FetchAll:    all,
+				Concurrency: workers,
+				Filter:      filter,
+				Fields:      withDefaultFields(fields),
 			}
 
-			result, err := client.ListRecordings(clubSlug, opts)
+			result, err := listRecordings(cmd.Context(), client, s.Club, opts, match, limit, sortKey, reverse)
 			if err != nil {
-				return fmt.Errorf("failed to list recordings: %w", err)
+				return err
 			}
 
-			// Output as JSON if requested
-			if jsonOutput {
-				encoder := json.NewEncoder(os.Stdout)
-				encoder.SetIndent("", "  ")
-				if err := encoder.Encode(result.Recordings); err != nil {
-					return fmt.Errorf("failed to encode JSON: %w", err)
-				}
-				return nil
+			if err := output.write(cmd.Context(), cmd.OutOrStdout(), client, format, result.Recordings, listTable(result.Recordings, columns)); err != nil {
+				return err
 			}
-
-			// Print results in table format
-			w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-			fmt.Fprintln(w, "ID\tTITLE\tDURATION\tDATE")
-
-			// Calculate title truncation length based on terminal width
-			titleMaxLen := calculateTitleMaxLength()
-
-			for _, r := range result.Recordings {
-				duration := formatDuration(r.Duration)
-				// Convert to local timezone - use Start (match date set in UI)
-				// Note: UI only sets date, time defaults to noon UTC
-				localTime := r.Start.Local()
-				date := localTime.Format("2006-01-02 15:04")
-				title := truncateString(r.Title, titleMaxLen)
-				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", r.Identifier, title, duration, date)
+			if format != formatTable {
+				return nil
 			}
-			w.Flush()
 
 			// Show total from API
 			if result.TotalCount > 0 {
@@ -91,14 +133,47 @@ func NewListCmd() *cobra.Command {
 		},
 	}
 
-	cmd.Flags().StringVarP(&clubSlug, "club", "c", "", "Club slug (or set VEO_CLUB environment variable)")
+	cmd.Flags().StringVarP(&clubSlug, "club", "c", "", "Club slug (or set VEO_CLUB or a config profile)")
 	cmd.Flags().IntVarP(&page, "page", "p", 1, "Page number (default: 1)")
 	cmd.Flags().BoolVarP(&all, "all", "a", false, "Fetch all pages")
-	cmd.Flags().BoolVarP(&jsonOutput, "json", "j", false, "Output as JSON")
+	cmd.Flags().StringVar(&filter, "filter", veo.FilterOwn, "Which recordings to list; passed to the API as is (only own is known to work)")
+	cmd.Flags().StringVar(&since, "since", "", "Only recordings on or after this date")
+	cmd.Flags().StringVar(&until, "until", "", "Only recordings on or before this date")
+	cmd.Flags().StringVar(&titlePattern, "title", "", "Only recordings whose title matches this regular expression (case-insensitive)")
+	cmd.Flags().StringVar(&matchType, "type", "", "Only recordings of this type (match, tournament, training, scrimmage)")
+	cmd.Flags().DurationVar(&minDuration, "min-duration", 0, "Only recordings at least this long, e.g. 30m")
+	cmd.Flags().DurationVar(&maxDuration, "max-duration", 0, "Only recordings at most this long, e.g. 1h30m")
+	cmd.Flags().StringVar(&camera, "camera", "", "Only recordings from this camera")
+	cmd.Flags().StringVar(&team, "team", "", "Only recordings of this team")
+	cmd.Flags().BoolVar(&accessibleOnly, "accessible-only", false, "Only recordings you can access")
+	cmd.Flags().StringVar(&sortKey, "sort", "", "Sort by start, created, duration or title (ascending)")
+	cmd.Flags().BoolVarP(&reverse, "reverse", "r", false, "Reverse the order (descending with --sort)")
+	cmd.Flags().StringSliceVar(&columnNames, "columns", nil, "Table columns: id, slug, title, type, duration, date, created, camera, team, privacy, accessible, url, thumbnail, reel (default id,title,duration,date)")
+	cmd.Flags().StringSliceVar(&fields, "fields", nil, "Extra API fields to request, e.g. processing_status,device (included in JSON output)")
+	cmd.Flags().IntVar(&workers, "workers", defaultPageWorkers, "Number of pages fetched at once with --all")
+	cmd.Flags().IntVarP(&limit, "limit", "n", 0, "Show at most this many recordings, fetching further pages only as needed (all pages with --sort)")
+	output = addOutputFlags(cmd)
 
 	return cmd
 }
 
+// withDefaultFields returns the default recording fields followed by any
+// extra fields not already among them, or nil if there are no extras
+func withDefaultFields(extra []string) []string {
+	if len(extra) == 0 {
+		return nil
+	}
+
+	fields := append([]string{}, veo.DefaultRecordingFields...)
+	for _, f := range extra {
+		f = strings.TrimSpace(f)
+		if f != "" && !contains(fields, f) {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
 // formatDuration formats seconds into HH:MM:SS
 func formatDuration(seconds int) string {
 	d := time.Duration(seconds) * time.Second
@@ -108,8 +183,38 @@ func formatDuration(seconds int) string {
 	return fmt.Sprintf("%02d:%02d:%02d", h, m, s)
 }
 
-// calculateTitleMaxLength determines title truncation length based on terminal width
-func calculateTitleMaxLength() int {
+// listRecordings fetches the recordings for the list command, filtered
+// client-side when needed, then sorts them and applies limit. With a sort key
+// every matching recording is fetched first, so the limit keeps the top
+// recordings by that key rather than the first ones the API lists.
+func listRecordings(ctx context.Context, client *veo.Client, clubSlug string, opts *veo.ListRecordingsOptions, filter *recordingFilter, limit int, sortKey string, reverse bool) (*veo.ListRecordingsResult, error) {
+	var result *veo.ListRecordingsResult
+	var err error
+	switch {
+	case sortKey != "" && limit > 0:
+		result, err = listMatching(ctx, client, clubSlug, opts, filter, 0)
+	case limit > 0 || filter.active():
+		result, err = listMatching(ctx, client, clubSlug, opts, filter, limit)
+	default:
+		result, err = client.ListRecordings(ctx, clubSlug, opts)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recordings: %w", err)
+	}
+
+	if err := sortRecordings(result.Recordings, sortKey, reverse); err != nil {
+		return nil, err
+	}
+	if limit > 0 && len(result.Recordings) > limit {
+		result.Recordings = result.Recordings[:limit]
+	}
+
+	return result, nil
+}
+
+// calculateTitleMaxLength determines title truncation length based on terminal
+// width and the width taken by the other columns
+func calculateTitleMaxLength(fixedWidth int) int {
 	// Check if stdout is a terminal
 	if !term.IsTerminal(int(os.Stdout.Fd())) {
 		// Not a terminal (piped/redirected) - don't truncate
@@ -124,8 +229,6 @@ func calculateTitleMaxLength() int {
 	}
 
 	// Calculate available space for title
-	// Format: ID (36) + spacing (2) + DURATION (8) + spacing (2) + CREATED (16) + spacing (6) = 70
-	const fixedWidth = 70
 	titleWidth := width - fixedWidth
 
 	// Set reasonable bounds
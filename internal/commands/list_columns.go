@@ -0,0 +1,112 @@
+package commands
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/justincampbell/veo"
+)
+
+// listColumn is a column of the list table
+type listColumn struct {
+	header string
+	value  func(r veo.Recording) string
+}
+
+// listColumns are the columns available to --columns, by name
+var listColumns = map[string]listColumn{
+	"id":         {"ID", func(r veo.Recording) string { return r.Identifier }},
+	"slug":       {"SLUG", func(r veo.Recording) string { return r.Slug }},
+	"title":      {"TITLE", func(r veo.Recording) string { return r.Title }},
+	"type":       {"TYPE", func(r veo.Recording) string { return r.Type }},
+	"duration":   {"DURATION", func(r veo.Recording) string { return formatDuration(r.Duration) }},
+	"date":       {"DATE", func(r veo.Recording) string { return formatLocalTime(r.Start) }},
+	"created":    {"CREATED", func(r veo.Recording) string { return formatLocalTime(r.Created) }},
+	"camera":     {"CAMERA", func(r veo.Recording) string { return r.Camera }},
+	"team":       {"TEAM", func(r veo.Recording) string { return r.Team }},
+	"privacy":    {"PRIVACY", func(r veo.Recording) string { return r.Privacy }},
+	"accessible": {"ACCESSIBLE", func(r veo.Recording) string { return strconv.FormatBool(r.IsAccessible) }},
+	"url":        {"URL", func(r veo.Recording) string { return r.URL }},
+	"thumbnail":  {"THUMBNAIL", func(r veo.Recording) string { return r.Thumbnail }},
+	"reel":       {"REEL", func(r veo.Recording) string { return r.ReelURL }},
+}
+
+// defaultListColumns are the columns shown without --columns
+var defaultListColumns = []string{"id", "title", "duration", "date"}
+
+// listSortKeys compare recordings for --sort
+var listSortKeys = map[string]func(a, b veo.Recording) int{
+	"start":    func(a, b veo.Recording) int { return a.Start.Compare(b.Start) },
+	"created":  func(a, b veo.Recording) int { return a.Created.Compare(b.Created) },
+	"duration": func(a, b veo.Recording) int { return cmp.Compare(a.Duration, b.Duration) },
+	"title": func(a, b veo.Recording) int {
+		return cmp.Compare(strings.ToLower(a.Title), strings.ToLower(b.Title))
+	},
+}
+
+// formatLocalTime formats a timestamp in local time for the list table.
+// The UI only sets the match date; its time defaults to noon UTC.
+func formatLocalTime(t time.Time) string {
+	return t.Local().Format("2006-01-02 15:04")
+}
+
+// parseListColumns validates --columns names
+func parseListColumns(names []string) ([]string, error) {
+	if len(names) == 0 {
+		return defaultListColumns, nil
+	}
+
+	var columns []string
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if _, ok := listColumns[name]; !ok {
+			return nil, fmt.Errorf("unknown column %q (valid: %s)", name, strings.Join(slices.Sorted(maps.Keys(listColumns)), ", "))
+		}
+		columns = append(columns, name)
+	}
+	return columns, nil
+}
+
+// sortRecordings sorts recordings by key in ascending order, or descending if
+// reverse is set, keeping the API order for ties. An empty key keeps the API
+// order, which reverse flips.
+func sortRecordings(recordings []veo.Recording, key string, reverse bool) error {
+	if key == "" {
+		if reverse {
+			slices.Reverse(recordings)
+		}
+		return nil
+	}
+
+	compare, ok := listSortKeys[key]
+	if !ok {
+		return fmt.Errorf("invalid sort %q (valid: %s)", key, strings.Join(slices.Sorted(maps.Keys(listSortKeys)), ", "))
+	}
+	if reverse {
+		ascending := compare
+		compare = func(a, b veo.Recording) int { return ascending(b, a) }
+	}
+	slices.SortStableFunc(recordings, compare)
+	return nil
+}
+
+// listTable renders the chosen columns of recordings as a table
+func listTable(recordings []veo.Recording, columns []string) *table {
+	t := &table{flexible: listColumns["title"].header}
+	for _, name := range columns {
+		t.headers = append(t.headers, listColumns[name].header)
+	}
+	for _, r := range recordings {
+		row := make([]string, len(columns))
+		for j, name := range columns {
+			row[j] = listColumns[name].value(r)
+		}
+		t.rows = append(t.rows, row)
+	}
+	return t
+}
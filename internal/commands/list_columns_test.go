@@ -0,0 +1,88 @@
+package commands
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/justincampbell/veo"
+)
+
+func TestSortRecordings(t *testing.T) {
+	recordings := func() []veo.Recording {
+		return []veo.Recording{
+			{Identifier: "b", Title: "beta", Duration: 300, Start: time.Date(2025, 11, 2, 0, 0, 0, 0, time.UTC)},
+			{Identifier: "a", Title: "Alpha", Duration: 100, Start: time.Date(2025, 11, 3, 0, 0, 0, 0, time.UTC)},
+			{Identifier: "c", Title: "gamma", Duration: 100, Start: time.Date(2025, 11, 1, 0, 0, 0, 0, time.UTC)},
+		}
+	}
+	ids := func(recordings []veo.Recording) []string {
+		var result []string
+		for _, r := range recordings {
+			result = append(result, r.Identifier)
+		}
+		return result
+	}
+
+	tests := []struct {
+		key      string
+		reverse  bool
+		expected []string
+	}{
+		{key: "", expected: []string{"b", "a", "c"}},
+		{key: "", reverse: true, expected: []string{"c", "a", "b"}},
+		{key: "start", expected: []string{"c", "b", "a"}},
+		{key: "title", expected: []string{"a", "b", "c"}},
+		{key: "duration", expected: []string{"a", "c", "b"}},
+		{key: "duration", reverse: true, expected: []string{"b", "a", "c"}},
+	}
+
+	for _, tt := range tests {
+		r := recordings()
+		if err := sortRecordings(r, tt.key, tt.reverse); err != nil {
+			t.Fatalf("sortRecordings(%q) failed: %v", tt.key, err)
+		}
+		if got := ids(r); !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("sortRecordings(%q, reverse=%v) = %v, expected %v", tt.key, tt.reverse, got, tt.expected)
+		}
+	}
+
+	if err := sortRecordings(recordings(), "size", false); err == nil {
+		t.Error("expected error for unknown sort key")
+	}
+}
+
+func TestParseListColumns(t *testing.T) {
+	columns, err := parseListColumns(nil)
+	if err != nil || !reflect.DeepEqual(columns, defaultListColumns) {
+		t.Errorf("expected default columns, got %v (%v)", columns, err)
+	}
+
+	columns, err = parseListColumns([]string{"Slug", " camera", "title"})
+	if err != nil {
+		t.Fatalf("parseListColumns failed: %v", err)
+	}
+	if expected := []string{"slug", "camera", "title"}; !reflect.DeepEqual(columns, expected) {
+		t.Errorf("expected %v, got %v", expected, columns)
+	}
+
+	if _, err := parseListColumns([]string{"bogus"}); err == nil {
+		t.Error("expected error for unknown column")
+	}
+}
+
+func TestListTable(t *testing.T) {
+	tbl := listTable([]veo.Recording{
+		{Slug: "20251116-match", Title: "Match", Duration: 3600},
+	}, []string{"slug", "title", "duration"})
+
+	if expected := []string{"SLUG", "TITLE", "DURATION"}; !reflect.DeepEqual(tbl.headers, expected) {
+		t.Errorf("expected headers %v, got %v", expected, tbl.headers)
+	}
+	if expected := [][]string{{"20251116-match", "Match", "01:00:00"}}; !reflect.DeepEqual(tbl.rows, expected) {
+		t.Errorf("expected rows %v, got %v", expected, tbl.rows)
+	}
+	if tbl.flexible != "TITLE" {
+		t.Errorf("expected TITLE to be flexible, got %q", tbl.flexible)
+	}
+}
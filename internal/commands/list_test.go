@@ -1,7 +1,14 @@
 package commands
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
 	"testing"
+
+	"github.com/justincampbell/veo"
 )
 
 func TestTruncateString(t *testing.T) {
@@ -103,3 +110,89 @@ func TestFormatDuration(t *testing.T) {
 		})
 	}
 }
+
+func TestListMatchingLimit(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		page = max(page, 1)
+		w.Header().Set("Link", fmt.Sprintf(`</clubs/test-club/recordings/?page=%d>; rel="next"`, page+1))
+		fmt.Fprintf(w, `[{"identifier": "id%d-1"}, {"identifier": "id%d-2"}, {"identifier": "id%d-3"}]`, page, page, page)
+	}))
+	defer server.Close()
+
+	client := veo.NewClient(veo.WithBaseURL(server.URL), veo.WithAuthToken("test-token"))
+	result, err := listMatching(context.Background(), client, "test-club", &veo.ListRecordingsOptions{FetchAll: true}, &recordingFilter{}, 5)
+	if err != nil {
+		t.Fatalf("listMatching failed: %v", err)
+	}
+
+	if len(result.Recordings) != 5 {
+		t.Errorf("expected 5 recordings, got %d", len(result.Recordings))
+	}
+	if last := result.Recordings[4].Identifier; last != "id2-2" {
+		t.Errorf("expected last recording id2-2, got %q", last)
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
+
+func TestWithDefaultFields(t *testing.T) {
+	if fields := withDefaultFields(nil); fields != nil {
+		t.Errorf("expected nil without extra fields, got %v", fields)
+	}
+
+	fields := withDefaultFields([]string{"processing_status", "title", " device "})
+	expected := len(veo.DefaultRecordingFields) + 2
+	if len(fields) != expected {
+		t.Fatalf("expected %d fields, got %v", expected, fields)
+	}
+	if fields[expected-2] != "processing_status" || fields[expected-1] != "device" {
+		t.Errorf("expected extra fields at the end, got %v", fields[expected-2:])
+	}
+}
+
+func TestListRecordingsSortBeforeLimit(t *testing.T) {
+	// Three pages, with the longest recordings on the last one
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		page = max(page, 1)
+		if page < 3 {
+			w.Header().Set("Link", fmt.Sprintf(`</clubs/test-club/recordings/?page=%d>; rel="next"`, page+1))
+		}
+		fmt.Fprintf(w, `[{"identifier": "id%d-1", "duration": %d}, {"identifier": "id%d-2", "duration": %d}]`,
+			page, page*100, page, page*100+50)
+	}))
+	defer server.Close()
+
+	client := veo.NewClient(veo.WithBaseURL(server.URL), veo.WithAuthToken("test-token"))
+	result, err := listRecordings(context.Background(), client, "test-club", &veo.ListRecordingsOptions{}, &recordingFilter{}, 3, "duration", true)
+	if err != nil {
+		t.Fatalf("listRecordings failed: %v", err)
+	}
+
+	var ids []string
+	for _, r := range result.Recordings {
+		ids = append(ids, r.Identifier)
+	}
+	if got := fmt.Sprint(ids); got != "[id3-2 id3-1 id2-2]" {
+		t.Errorf("expected the 3 longest recordings, got %s", got)
+	}
+	if requests != 3 {
+		t.Errorf("expected every page to be fetched, got %d requests", requests)
+	}
+
+	// Without a sort, the limit still stops fetching early
+	requests = 0
+	result, err = listRecordings(context.Background(), client, "test-club", &veo.ListRecordingsOptions{}, &recordingFilter{}, 2, "", false)
+	if err != nil {
+		t.Fatalf("listRecordings failed: %v", err)
+	}
+	if len(result.Recordings) != 2 || requests != 1 {
+		t.Errorf("expected 2 recordings from 1 request, got %d from %d", len(result.Recordings), requests)
+	}
+}
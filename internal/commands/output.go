@@ -0,0 +1,263 @@
+package commands
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+
+	"github.com/justincampbell/veo"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// Output formats for --output
+const (
+	formatTable    = "table"
+	formatJSON     = "json"
+	formatNDJSON   = "ndjson"
+	formatCSV      = "csv"
+	formatTSV      = "tsv"
+	formatYAML     = "yaml"
+	formatMarkdown = "markdown"
+)
+
+// outputFormats are the valid values for --output
+var outputFormats = []string{formatTable, formatJSON, formatNDJSON, formatCSV, formatTSV, formatYAML, formatMarkdown}
+
+// tablePadding is the space between table columns
+const tablePadding = 2
+
+// outputFlags holds a command's --output, --json and --format flags
+type outputFlags struct {
+	output   string
+	json     bool
+	template string
+
+	tmpl *template.Template // Parsed --format template
+}
+
+// addOutputFlags adds --output, its --json shorthand and --format to cmd
+func addOutputFlags(cmd *cobra.Command) *outputFlags {
+	f := &outputFlags{}
+	cmd.Flags().StringVarP(&f.output, "output", "o", "", "Output format: table, json, ndjson, csv, tsv, yaml or markdown")
+	cmd.Flags().BoolVarP(&f.json, "json", "j", false, "Output as JSON (same as --output json)")
+	cmd.Flags().StringVar(&f.template, "format", "", "Render each item with a Go template, e.g. '{{.Title}}' (see 'veo help templates')")
+	return f
+}
+
+// resolve returns the output format: a --format template if given, then
+// --json, then --output, then the configured output format, then table
+func (f *outputFlags) resolve(cmd *cobra.Command, s *settings) (string, error) {
+	if cmd.Flags().Changed("format") {
+		if cmd.Flags().Changed("output") || cmd.Flags().Changed("json") {
+			return "", fmt.Errorf("--format cannot be combined with --output or --json")
+		}
+		tmpl, err := parseFormatTemplate(f.template)
+		if err != nil {
+			return "", err
+		}
+		f.tmpl = tmpl
+		return formatTemplate, nil
+	}
+
+	format := formatTable
+	switch {
+	case cmd.Flags().Changed("json"):
+		if f.json {
+			format = formatJSON
+		}
+	case cmd.Flags().Changed("output"):
+		format = f.output
+	case s.Output != "":
+		format = s.Output
+	}
+
+	format = strings.ToLower(format)
+	if !contains(outputFormats, format) {
+		return "", fmt.Errorf("invalid output format %q (valid: %s)", format, strings.Join(outputFormats, ", "))
+	}
+	return format, nil
+}
+
+// write writes data in format with writeOutput, or through the --format
+// template. client is used by template helpers that need the API.
+func (f *outputFlags) write(ctx context.Context, w io.Writer, client *veo.Client, format string, data any, t *table) error {
+	if format != formatTemplate {
+		return writeOutput(w, format, data, t)
+	}
+
+	periods := &periodsCache{ctx: ctx, client: client}
+	return executeTemplate(w, f.tmpl.Funcs(templateFuncs(periods.get)), data)
+}
+
+// table is tabular output, rendered as a table, CSV, TSV or Markdown
+type table struct {
+	headers []string
+	rows    [][]string
+
+	// flexible is the header of a column that is shortened to fit the
+	// terminal in table format, or "" for none
+	flexible string
+}
+
+// writeOutput writes data in format. Structured formats (JSON, NDJSON, YAML)
+// encode data; tabular formats render t.
+func writeOutput(w io.Writer, format string, data any, t *table) error {
+	switch format {
+	case formatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(data); err != nil {
+			return fmt.Errorf("failed to encode JSON: %w", err)
+		}
+		return nil
+	case formatNDJSON:
+		return writeNDJSON(w, data)
+	case formatYAML:
+		return writeYAML(w, data)
+	case formatCSV:
+		return writeDelimited(w, t, ',')
+	case formatTSV:
+		return writeDelimited(w, t, '\t')
+	case formatMarkdown:
+		return writeMarkdown(w, t)
+	default:
+		return writeTable(w, t)
+	}
+}
+
+// writeNDJSON writes each element of a slice as a line of JSON, or data as a
+// single line if it isn't a slice
+func writeNDJSON(w io.Writer, data any) error {
+	encoder := json.NewEncoder(w)
+
+	v := reflect.ValueOf(data)
+	if v.Kind() != reflect.Slice {
+		if err := encoder.Encode(data); err != nil {
+			return fmt.Errorf("failed to encode JSON: %w", err)
+		}
+		return nil
+	}
+
+	for i := 0; i < v.Len(); i++ {
+		if err := encoder.Encode(v.Index(i).Interface()); err != nil {
+			return fmt.Errorf("failed to encode JSON: %w", err)
+		}
+	}
+	return nil
+}
+
+// writeYAML writes data as YAML. It goes through JSON so field names and
+// omitted fields match the JSON output, and key order is kept.
+func writeYAML(w io.Writer, data any) error {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to encode YAML: %w", err)
+	}
+
+	// JSON is valid YAML, so parsing it keeps the structure and key order
+	var node yaml.Node
+	if err := yaml.Unmarshal(raw, &node); err != nil {
+		return fmt.Errorf("failed to encode YAML: %w", err)
+	}
+	resetYAMLStyle(&node)
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&node); err != nil {
+		return fmt.Errorf("failed to encode YAML: %w", err)
+	}
+	return encoder.Close()
+}
+
+// resetYAMLStyle clears the JSON flow style and quoting from a parsed node,
+// so it is written as block YAML
+func resetYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetYAMLStyle(child)
+	}
+}
+
+// writeDelimited writes t as CSV or TSV with a header row
+func writeDelimited(w io.Writer, t *table, comma rune) error {
+	cw := csv.NewWriter(w)
+	cw.Comma = comma
+	if err := cw.WriteAll(append([][]string{t.headers}, t.rows...)); err != nil {
+		return fmt.Errorf("failed to write output: %w", err)
+	}
+	return nil
+}
+
+// writeMarkdown writes t as a Markdown table
+func writeMarkdown(w io.Writer, t *table) error {
+	escape := strings.NewReplacer("|", `\|`, "\n", " ")
+	writeRow := func(cells []string) {
+		escaped := make([]string, len(cells))
+		for i, cell := range cells {
+			escaped[i] = escape.Replace(cell)
+		}
+		fmt.Fprintf(w, "| %s |\n", strings.Join(escaped, " | "))
+	}
+
+	writeRow(t.headers)
+	separator := make([]string, len(t.headers))
+	for i := range separator {
+		separator[i] = "---"
+	}
+	writeRow(separator)
+	for _, row := range t.rows {
+		writeRow(row)
+	}
+	return nil
+}
+
+// writeTable writes t aligned in columns, shortening the flexible column to
+// fit the terminal
+func writeTable(w io.Writer, t *table) error {
+	flexible := -1
+	for i, header := range t.headers {
+		if header == t.flexible && t.flexible != "" {
+			flexible = i
+		}
+	}
+
+	maxLen := 0
+	if flexible >= 0 {
+		maxLen = calculateTitleMaxLength(t.fixedWidth(flexible))
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, tablePadding, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(t.headers, "\t"))
+	for _, row := range t.rows {
+		if flexible >= 0 {
+			row = append([]string{}, row...)
+			row[flexible] = truncateString(row[flexible], maxLen)
+		}
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+// fixedWidth returns the width of every column but the flexible one, as laid
+// out by writeTable, so the flexible column can use the rest of the terminal
+func (t *table) fixedWidth(flexible int) int {
+	width := 0
+	for j, header := range t.headers {
+		if j == flexible {
+			continue
+		}
+		columnWidth := len(header)
+		for _, row := range t.rows {
+			columnWidth = max(columnWidth, len(row[j]))
+		}
+		width += columnWidth
+	}
+	return width + tablePadding*(len(t.headers)-1)
+}
@@ -0,0 +1,148 @@
+package commands
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+type outputItem struct {
+	ID    string   `json:"id"`
+	Title string   `json:"title"`
+	Tags  []string `json:"tags,omitempty"`
+}
+
+func outputFixture() ([]outputItem, *table) {
+	items := []outputItem{
+		{ID: "a", Title: "Match, Rivals", Tags: []string{"goal"}},
+		{ID: "b", Title: "Training | Drills"},
+	}
+	t := &table{
+		headers: []string{"ID", "TITLE"},
+		rows:    [][]string{{"a", "Match, Rivals"}, {"b", "Training | Drills"}},
+	}
+	return items, t
+}
+
+func TestWriteOutput(t *testing.T) {
+	tests := []struct {
+		format   string
+		expected string
+	}{
+		{
+			format:   formatCSV,
+			expected: "ID,TITLE\na,\"Match, Rivals\"\nb,Training | Drills\n",
+		},
+		{
+			format:   formatTSV,
+			expected: "ID\tTITLE\na\tMatch, Rivals\nb\tTraining | Drills\n",
+		},
+		{
+			format:   formatMarkdown,
+			expected: "| ID | TITLE |\n| --- | --- |\n| a | Match, Rivals |\n| b | Training \\| Drills |\n",
+		},
+		{
+			format:   formatNDJSON,
+			expected: "{\"id\":\"a\",\"title\":\"Match, Rivals\",\"tags\":[\"goal\"]}\n{\"id\":\"b\",\"title\":\"Training | Drills\"}\n",
+		},
+		{
+			format:   formatYAML,
+			expected: "- id: a\n  title: Match, Rivals\n  tags:\n    - goal\n- id: b\n  title: Training | Drills\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			items, tbl := outputFixture()
+			var buf bytes.Buffer
+			if err := writeOutput(&buf, tt.format, items, tbl); err != nil {
+				t.Fatalf("writeOutput failed: %v", err)
+			}
+			if buf.String() != tt.expected {
+				t.Errorf("unexpected %s output:\n%s\nexpected:\n%s", tt.format, buf.String(), tt.expected)
+			}
+		})
+	}
+}
+
+func TestWriteYAMLQuotesAmbiguousStrings(t *testing.T) {
+	var buf bytes.Buffer
+	if err := writeYAML(&buf, map[string]string{"value": "true"}); err != nil {
+		t.Fatalf("writeYAML failed: %v", err)
+	}
+	if !strings.Contains(buf.String(), `"true"`) {
+		t.Errorf("expected string \"true\" to stay quoted, got %s", buf.String())
+	}
+}
+
+func TestWriteTable(t *testing.T) {
+	_, tbl := outputFixture()
+	var buf bytes.Buffer
+	if err := writeOutput(&buf, formatTable, nil, tbl); err != nil {
+		t.Fatalf("writeOutput failed: %v", err)
+	}
+
+	expected := "ID  TITLE\na   Match, Rivals\nb   Training | Drills\n"
+	if buf.String() != expected {
+		t.Errorf("unexpected table:\n%s\nexpected:\n%s", buf.String(), expected)
+	}
+}
+
+func TestTableFixedWidth(t *testing.T) {
+	tbl := &table{
+		headers: []string{"SLUG", "TITLE", "DURATION"},
+		rows: [][]string{
+			{"20251116-match", "A very long title", "01:00:00"},
+			{"short", "Short", "00:01:00"},
+		},
+	}
+
+	// slug (14) + duration (8) + 2 gaps of 2
+	if width := tbl.fixedWidth(1); width != 26 {
+		t.Errorf("expected fixed width 26, got %d", width)
+	}
+}
+
+func TestOutputFlagsResolve(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		configured string
+		expected   string
+		wantErr    bool
+	}{
+		{name: "default", expected: formatTable},
+		{name: "configured", configured: "yaml", expected: formatYAML},
+		{name: "output flag", args: []string{"-o", "csv"}, configured: "yaml", expected: formatCSV},
+		{name: "json flag", args: []string{"--json"}, expected: formatJSON},
+		{name: "json flag false", args: []string{"--json=false"}, configured: "json", expected: formatTable},
+		{name: "case insensitive", args: []string{"--output", "NDJSON"}, expected: formatNDJSON},
+		{name: "invalid", args: []string{"-o", "xml"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			output := addOutputFlags(cmd)
+			if err := cmd.ParseFlags(tt.args); err != nil {
+				t.Fatalf("ParseFlags failed: %v", err)
+			}
+
+			format, err := output.resolve(cmd, &settings{Output: tt.configured})
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("expected error, got format %q", format)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolve failed: %v", err)
+			}
+			if format != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, format)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/justincampbell/veo"
+	"github.com/spf13/cobra"
+)
+
+// NewPeriodsCmd creates the periods command
+func NewPeriodsCmd() *cobra.Command {
+	var clubSlug string
+	var output *outputFlags
+
+	cmd := &cobra.Command{
+		Use:   "periods [recording-id|latest]",
+		Short: "List the periods (halves) of a recording",
+		Long: `List the periods of a recording, such as halves, with their start and end
+offsets into the video. The start of the first period is the kickoff.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			s, err := loadSettings(cmd, clubSlug)
+			if err != nil {
+				return err
+			}
+			format, err := output.resolve(cmd, s)
+			if err != nil {
+				return err
+			}
+			if err := s.requireToken(); err != nil {
+				return err
+			}
+
+			// Create API client
+			client := s.newClient()
+
+			details, err := resolveRecording(cmd, client, s, args)
+			if err != nil {
+				return err
+			}
+
+			periods, err := client.GetPeriods(cmd.Context(), details.Slug)
+			if err != nil {
+				return fmt.Errorf("failed to get periods: %w", err)
+			}
+
+			return output.write(cmd.Context(), cmd.OutOrStdout(), client, format, periods, periodsTable(periods))
+		},
+	}
+
+	cmd.Flags().StringVarP(&clubSlug, "club", "c", "", "Club slug (required to select recordings by other than ID, or set VEO_CLUB or a config profile)")
+	output = addOutputFlags(cmd)
+
+	return cmd
+}
+
+// periodsTable renders periods as a table
+func periodsTable(periods []veo.Period) *table {
+	t := &table{headers: []string{"NAME", "START", "END", "DURATION", "OWN SIDE", "CONFIRMED"}}
+	for _, p := range periods {
+		var start, end string
+		if len(p.Timeframe) == 2 {
+			start = formatTimestamp(p.Timeframe[0])
+			end = formatTimestamp(p.Timeframe[1])
+		}
+		t.rows = append(t.rows, []string{
+			p.Name,
+			start,
+			end,
+			formatDuration(p.Duration),
+			p.OwnSide,
+			strconv.FormatBool(p.IsConfirmed),
+		})
+	}
+	return t
+}
@@ -0,0 +1,257 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/justincampbell/veo"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// Keys handled by the picker, as read from a terminal in raw mode
+const (
+	keyCtrlC     = 3
+	keyBackspace = 8
+	keyEnter     = '\r'
+	keyCtrlN     = 14
+	keyCtrlP     = 16
+	keyCtrlU     = 21
+	keyEscape    = 27
+	keyDelete    = 127
+)
+
+// maxPickerRows is the most recordings the picker shows at once
+const maxPickerRows = 10
+
+// errPickerCancelled is returned when the picker is closed without choosing
+var errPickerCancelled = errors.New("no recording selected")
+
+// pickFunc chooses one of recordings interactively
+type pickFunc func(recordings []veo.Recording) (*veo.Recording, error)
+
+// terminalPicker returns a pickFunc that shows a recordingPicker on the
+// terminal, or nil if cmd's input or error output isn't a terminal
+func terminalPicker(cmd *cobra.Command) pickFunc {
+	in, inOK := cmd.InOrStdin().(*os.File)
+	out, outOK := cmd.ErrOrStderr().(*os.File)
+	if !inOK || !outOK || !term.IsTerminal(int(in.Fd())) || !term.IsTerminal(int(out.Fd())) {
+		return nil
+	}
+
+	return func(recordings []veo.Recording) (*veo.Recording, error) {
+		width, height, err := term.GetSize(int(out.Fd()))
+		if err != nil {
+			width, height = 80, 24
+		}
+
+		state, err := term.MakeRaw(int(in.Fd()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to start the recording picker: %w", err)
+		}
+		defer term.Restore(int(in.Fd()), state)
+
+		return newRecordingPicker(recordings, width, height).run(in, out)
+	}
+}
+
+// recordingPicker is a fuzzy-search list of recordings, driven by keys read
+// from a terminal in raw mode
+type recordingPicker struct {
+	recordings []veo.Recording
+	labels     []string // Lowercased text searched for each recording
+
+	query   string
+	matches []int // Indexes of the recordings matching query, best first
+	cursor  int   // Selected position in matches
+	offset  int   // First position in matches that is shown
+
+	width, rows int
+}
+
+// newRecordingPicker creates a picker sized for a terminal of width by height
+func newRecordingPicker(recordings []veo.Recording, width, height int) *recordingPicker {
+	p := &recordingPicker{
+		recordings: recordings,
+		labels:     make([]string, len(recordings)),
+		width:      width,
+		rows:       max(min(height-2, maxPickerRows), 1),
+	}
+	for i, r := range recordings {
+		p.labels[i] = strings.ToLower(r.Title + " " + formatLocalTime(r.Start))
+	}
+	p.filter()
+	return p
+}
+
+// run reads keys from in and draws the picker on out until a recording is
+// chosen or the picker is cancelled
+func (p *recordingPicker) run(in io.Reader, out io.Writer) (*veo.Recording, error) {
+	fmt.Fprint(out, "\x1b[?25l") // Hide the cursor
+	defer fmt.Fprint(out, "\x1b[?25h")
+
+	lines := p.render(out)
+	clear := func() { fmt.Fprintf(out, "\x1b[%dA\r\x1b[J", lines) }
+
+	buf := make([]byte, 64)
+	for {
+		n, err := in.Read(buf)
+		for keys := buf[:n]; len(keys) > 0; {
+			var selected, cancelled bool
+			keys, selected, cancelled = p.key(keys)
+			switch {
+			case cancelled:
+				clear()
+				return nil, errPickerCancelled
+			case selected && len(p.matches) > 0:
+				clear()
+				return &p.recordings[p.matches[p.cursor]], nil
+			}
+		}
+		if err == io.EOF {
+			clear()
+			return nil, errPickerCancelled
+		}
+		if err != nil {
+			clear()
+			return nil, fmt.Errorf("failed to read input: %w", err)
+		}
+
+		clear()
+		lines = p.render(out)
+	}
+}
+
+// key handles the first key in keys and returns the rest
+func (p *recordingPicker) key(keys []byte) (rest []byte, selected, cancelled bool) {
+	switch k := keys[0]; {
+	case k == keyEscape && len(keys) >= 3 && (keys[1] == '[' || keys[1] == 'O'):
+		// Arrow keys are ESC [ A, or ESC O A in application cursor mode
+		switch keys[2] {
+		case 'A':
+			p.move(-1)
+		case 'B':
+			p.move(1)
+		}
+		return keys[3:], false, false
+	case k == keyEscape, k == keyCtrlC:
+		return nil, false, true
+	case k == keyEnter, k == '\n':
+		return keys[1:], true, false
+	case k == keyCtrlP:
+		p.move(-1)
+	case k == keyCtrlN:
+		p.move(1)
+	case k == keyBackspace, k == keyDelete:
+		if p.query != "" {
+			_, size := utf8.DecodeLastRuneInString(p.query)
+			p.query = p.query[:len(p.query)-size]
+			p.filter()
+		}
+	case k == keyCtrlU:
+		p.query = ""
+		p.filter()
+	case k >= ' ':
+		// Typed text may be UTF-8, so add whole characters
+		r, size := utf8.DecodeRune(keys)
+		if r != utf8.RuneError {
+			p.query += string(r)
+			p.filter()
+		}
+		return keys[size:], false, false
+	}
+	return keys[1:], false, false
+}
+
+// move moves the selection by delta, scrolling the list to keep it shown
+func (p *recordingPicker) move(delta int) {
+	if len(p.matches) == 0 {
+		return
+	}
+	p.cursor = max(min(p.cursor+delta, len(p.matches)-1), 0)
+	if p.cursor < p.offset {
+		p.offset = p.cursor
+	}
+	if p.cursor >= p.offset+p.rows {
+		p.offset = p.cursor - p.rows + 1
+	}
+}
+
+// filter finds the recordings matching the query, closest matches first and
+// otherwise in their original order, and selects the first
+func (p *recordingPicker) filter() {
+	query := strings.ToLower(p.query)
+	scores := make(map[int]int)
+	p.matches = p.matches[:0]
+	for i, label := range p.labels {
+		if score, ok := fuzzyMatch(label, query); ok {
+			p.matches = append(p.matches, i)
+			scores[i] = score
+		}
+	}
+	slices.SortStableFunc(p.matches, func(a, b int) int {
+		return scores[a] - scores[b]
+	})
+	p.cursor, p.offset = 0, 0
+}
+
+// fuzzyMatch reports whether the characters of query appear in text in
+// order. The score is the length of text they span, so lower is closer.
+func fuzzyMatch(text, query string) (score int, ok bool) {
+	if query == "" {
+		return 0, true
+	}
+
+	// Prefer the shortest span, trying each place the query could start
+	best := -1
+	for start := range text {
+		if !strings.HasPrefix(text[start:], query[:1]) {
+			continue
+		}
+		pos, i := start, 0
+		for i < len(query) && pos < len(text) {
+			if text[pos] == query[i] {
+				i++
+			}
+			pos++
+		}
+		if i < len(query) {
+			break
+		}
+		if span := pos - start; best < 0 || span < best {
+			best = span
+		}
+	}
+	return best, best >= 0
+}
+
+// render draws the query and the shown matches, and returns the number of
+// lines drawn
+func (p *recordingPicker) render(out io.Writer) int {
+	fmt.Fprintf(out, "Recording: %s  \x1b[2m%d/%d\x1b[0m\r\n", p.query, len(p.matches), len(p.recordings))
+
+	const dateWidth, durationWidth = len("2006-01-02 15:04"), len("00:00:00")
+	titleWidth := max(p.width-2-dateWidth-durationWidth-2*tablePadding, 10)
+	padding := strings.Repeat(" ", tablePadding)
+
+	lines := 1
+	for pos := p.offset; pos < len(p.matches) && pos < p.offset+p.rows; pos++ {
+		r := p.recordings[p.matches[pos]]
+		row := fmt.Sprintf("%-*s%s%-*s%s%s",
+			titleWidth, truncateString(r.Title, titleWidth), padding,
+			dateWidth, formatLocalTime(r.Start), padding,
+			formatDuration(r.Duration))
+		if pos == p.cursor {
+			fmt.Fprintf(out, "\x1b[7m> %s\x1b[0m\r\n", row)
+		} else {
+			fmt.Fprintf(out, "  %s\r\n", row)
+		}
+		lines++
+	}
+	return lines
+}
@@ -0,0 +1,182 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/justincampbell/veo"
+	"github.com/spf13/cobra"
+)
+
+func pickerRecordings() []veo.Recording {
+	start := time.Date(2025, 11, 23, 12, 0, 0, 0, time.UTC)
+	return []veo.Recording{
+		{Identifier: "id-4", Title: "Match - Rivals FC", Start: start, Duration: 5400},
+		{Identifier: "id-3", Title: "Match - City United", Start: start.AddDate(0, 0, -7), Duration: 5400},
+		{Identifier: "id-2", Title: "Match - Town United", Start: start.AddDate(0, 0, -14), Duration: 5400},
+		{Identifier: "id-1", Title: "Training", Start: start.AddDate(0, 0, -14), Duration: 3600},
+	}
+}
+
+func TestRecordingPickerRun(t *testing.T) {
+	tests := []struct {
+		name     string
+		keys     string
+		expected string
+	}{
+		{"first by default", "\r", "id-4"},
+		{"down arrow", "\x1b[B\x1b[B\r", "id-2"},
+		{"application mode arrows", "\x1bOB\x1bOB\x1bOA\r", "id-3"},
+		{"up arrow stops at the top", "\x1b[A\x1b[B\x1b[A\x1b[A\r", "id-4"},
+		{"ctrl-n and ctrl-p", "\x0e\x0e\x0e\x10\r", "id-2"},
+		{"down arrow stops at the bottom", strings.Repeat("\x1b[B", 10) + "\r", "id-1"},
+		{"search", "town\r", "id-2"},
+		{"fuzzy search", "mtchcty\r", "id-3"},
+		{"search is case insensitive", "TRAIN\r", "id-1"},
+		{"search by date", "2025-11-16\r", "id-3"},
+		{"backspace", "trainx\x7f\x7f\x7f\x7f\x7f\x7frivals\r", "id-4"},
+		{"ctrl-u clears the query", "training\x15\x1b[B\r", "id-3"},
+		{"closest match first", "united\r", "id-3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newRecordingPicker(pickerRecordings(), 80, 24)
+			r, err := p.run(strings.NewReader(tt.keys), io.Discard)
+			if err != nil {
+				t.Fatalf("run failed: %v", err)
+			}
+			if r.Identifier != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, r.Identifier)
+			}
+		})
+	}
+}
+
+func TestRecordingPickerUTF8Search(t *testing.T) {
+	recordings := append(pickerRecordings(), veo.Recording{Identifier: "id-5", Title: "Match - Ørsted FC"})
+
+	tests := []struct {
+		name     string
+		keys     string
+		expected string
+	}{
+		{"typed", "ørsted\r", "id-5"},
+		{"uppercase", "ØRSTED\r", "id-5"},
+		{"backspace removes the whole character", "øx\x7f\x7frivals\r", "id-4"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newRecordingPicker(recordings, 80, 24)
+			r, err := p.run(strings.NewReader(tt.keys), io.Discard)
+			if err != nil {
+				t.Fatalf("run failed: %v", err)
+			}
+			if r.Identifier != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, r.Identifier)
+			}
+		})
+	}
+}
+
+func TestRecordingPickerCancel(t *testing.T) {
+	tests := []struct {
+		name string
+		keys string
+	}{
+		{"escape", "\x1b"},
+		{"ctrl-c", "rivals\x03"},
+		{"end of input", "rivals"},
+		{"no match", "wanderers\r"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newRecordingPicker(pickerRecordings(), 80, 24)
+			_, err := p.run(strings.NewReader(tt.keys), io.Discard)
+			if !errors.Is(err, errPickerCancelled) {
+				t.Errorf("expected errPickerCancelled, got %v", err)
+			}
+		})
+	}
+}
+
+func TestRecordingPickerRender(t *testing.T) {
+	p := newRecordingPicker(pickerRecordings(), 80, 4)
+	p.move(3)
+
+	var out bytes.Buffer
+	lines := p.render(&out)
+
+	// A 4 line terminal leaves room for the query and 2 recordings
+	if lines != 3 {
+		t.Errorf("expected 3 lines, got %d", lines)
+	}
+	rendered := out.String()
+	if !strings.Contains(rendered, "4/4") {
+		t.Errorf("expected match count, got:\n%s", rendered)
+	}
+	if strings.Contains(rendered, "City United") {
+		t.Errorf("expected the list to scroll past City United, got:\n%s", rendered)
+	}
+	if !strings.Contains(rendered, "> Training") {
+		t.Errorf("expected Training to be selected, got:\n%s", rendered)
+	}
+	if !strings.Contains(rendered, "01:00:00") {
+		t.Errorf("expected durations, got:\n%s", rendered)
+	}
+}
+
+func TestFuzzyMatch(t *testing.T) {
+	tests := []struct {
+		text, query string
+		score       int
+		ok          bool
+	}{
+		{"match - rivals fc", "", 0, true},
+		{"match - rivals fc", "rivals", 6, true},
+		{"match - rivals fc", "mrfc", 17, true},
+		{"match - rivals fc", "fcr", 0, false},
+		{"training", "tn", 5, true},
+		{"town united - united", "united", 6, true},
+	}
+
+	for _, tt := range tests {
+		score, ok := fuzzyMatch(tt.text, tt.query)
+		if ok != tt.ok || (ok && score != tt.score) {
+			t.Errorf("fuzzyMatch(%q, %q) = %d, %v, expected %d, %v", tt.text, tt.query, score, ok, tt.score, tt.ok)
+		}
+	}
+}
+
+func TestResolveRecordingArgNotInteractive(t *testing.T) {
+	client := newSelectorServer(t)
+	s := &settings{Club: "test-club"}
+
+	cmd := &cobra.Command{}
+	cmd.SetIn(strings.NewReader(""))
+	cmd.SetContext(context.Background())
+
+	if _, err := resolveRecordingArg(cmd, client, s, nil); err == nil || !strings.Contains(err.Error(), "recording argument is required") {
+		t.Errorf("expected a missing argument error, got %v", err)
+	}
+
+	var ambiguous *ambiguousSelectorError
+	if _, err := resolveRecordingArg(cmd, client, s, []string{"united"}); !errors.As(err, &ambiguous) {
+		t.Errorf("expected an ambiguous selector error, got %v", err)
+	}
+
+	sel, err := resolveRecordingArg(cmd, client, s, []string{"latest~1"})
+	if err != nil {
+		t.Fatalf("resolveRecordingArg failed: %v", err)
+	}
+	if sel.id != "id-3" {
+		t.Errorf("expected id-3, got %q", sel.id)
+	}
+}
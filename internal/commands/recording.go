@@ -0,0 +1,317 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+	"unicode"
+
+	"github.com/justincampbell/veo"
+	"github.com/spf13/cobra"
+)
+
+var (
+	// uuidPattern matches recording identifiers, which are used as is
+	uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+	// latestPattern matches "latest" and "latest~N"
+	latestPattern = regexp.MustCompile(`^latest(?:~(\d+))?$`)
+
+	// selectorDatePattern matches a match date
+	selectorDatePattern = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+	// fullSlugPattern matches complete slugs, which start with the match date
+	fullSlugPattern = regexp.MustCompile(`^\d{8}-`)
+)
+
+// ambiguousSelectorError is returned when a selector matches more than one
+// recording
+type ambiguousSelectorError struct {
+	selector string
+	matches  []veo.Recording
+}
+
+func (e *ambiguousSelectorError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%q matches %d recordings:\n\n", e.selector, len(e.matches))
+
+	w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	for _, r := range e.matches {
+		fmt.Fprintf(w, "  %s\t%s\t%s\n", r.Slug, formatLocalTime(r.Start), r.Title)
+	}
+	w.Flush()
+
+	b.WriteString("\nUse a recording ID, a longer slug prefix or a more specific title")
+	return b.String()
+}
+
+// selection is a recording picked by a selector
+type selection struct {
+	id      string
+	details *veo.RecordingDetails // Set if fetched while resolving the selector
+	byTitle *veo.Recording        // Set if the selector matched the title
+}
+
+// selectRecording turns a recording selector into a selection. A selector is
+// one of:
+//
+//	<uuid>        a recording identifier, used as is
+//	latest        the most recent recording; latest~N is the Nth before it
+//	first         the oldest recording
+//	2025-11-16    the recording that started on that day
+//	<slug>        a recording whose slug starts with this
+//	<title>       a recording whose title contains these words, e.g. the opponent
+//
+// Complete slugs are fetched directly, which also finds recordings that
+// aren't listed, such as ones shared with the club. Anything else, or a
+// complete slug that isn't found, needs the club, to list its recordings. See
+// also NewSelectorsHelpCmd.
+func selectRecording(ctx context.Context, client *veo.Client, s *settings, selector string) (*selection, error) {
+	if uuidPattern.MatchString(selector) {
+		return &selection{id: selector}, nil
+	}
+
+	if fullSlugPattern.MatchString(selector) {
+		details, err := client.GetRecording(ctx, selector)
+		if err == nil {
+			id := details.Identifier
+			if id == "" {
+				id = selector
+			}
+			return &selection{id: id, details: details}, nil
+		}
+		// A slug prefix such as 20251116-match isn't found, so is searched
+		// for below
+		if !veo.IsNotFound(err) || s.Club == "" {
+			return nil, fmt.Errorf("failed to get recording: %w", err)
+		}
+	}
+
+	if err := s.requireClub(); err != nil {
+		return nil, fmt.Errorf("club is required to find recording %q: %w", selector, err)
+	}
+
+	r, byTitle, err := findRecording(ctx, client, s.Club, selector)
+	if err != nil {
+		return nil, err
+	}
+	if r == nil {
+		return nil, fmt.Errorf("no recording matches %q", selector)
+	}
+
+	sel := &selection{id: r.Identifier}
+	if byTitle {
+		sel.byTitle = r
+	}
+	return sel, nil
+}
+
+// findRecording finds the recording of a club matching selector (see
+// selectRecording), and whether it matched by title. It returns nil if
+// none match, or an *ambiguousSelectorError if several do.
+func findRecording(ctx context.Context, client *veo.Client, clubSlug, selector string) (r *veo.Recording, byTitle bool, err error) {
+	if m := latestPattern.FindStringSubmatch(selector); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		r, err := nthRecording(ctx, client, clubSlug, n)
+		return r, false, err
+	}
+
+	if selector == "first" {
+		recordings, err := allRecordings(ctx, client, clubSlug)
+		if err != nil || len(recordings) == 0 {
+			return nil, false, err
+		}
+		return &recordings[len(recordings)-1], false, nil
+	}
+
+	if selectorDatePattern.MatchString(selector) {
+		start, end, err := parseDatePeriod(selector, time.Now())
+		if err != nil {
+			return nil, false, err
+		}
+		filter := &recordingFilter{since: start, until: end}
+		result, err := listMatching(ctx, client, clubSlug, &veo.ListRecordingsOptions{}, filter, 0)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to list recordings: %w", err)
+		}
+		r, err := onlyMatch(selector, result.Recordings)
+		return r, false, err
+	}
+
+	recordings, err := allRecordings(ctx, client, clubSlug)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var bySlug []veo.Recording
+	for _, r := range recordings {
+		if r.Slug == selector {
+			return &r, false, nil
+		}
+		if strings.HasPrefix(r.Slug, selector) {
+			bySlug = append(bySlug, r)
+		}
+	}
+	if len(bySlug) > 0 {
+		r, err := onlyMatch(selector, bySlug)
+		return r, false, err
+	}
+
+	r, err = onlyMatch(selector, matchTitle(recordings, selector))
+	return r, r != nil, err
+}
+
+// nthRecording returns the nth most recent recording, counting from 0
+func nthRecording(ctx context.Context, client *veo.Client, clubSlug string, n int) (*veo.Recording, error) {
+	i := 0
+	for r, err := range client.Recordings(ctx, clubSlug, nil) {
+		if err != nil {
+			return nil, fmt.Errorf("failed to list recordings: %w", err)
+		}
+		if i == n {
+			return &r, nil
+		}
+		i++
+	}
+
+	if i == 0 {
+		return nil, fmt.Errorf("no recordings found")
+	}
+	return nil, fmt.Errorf("latest~%d is out of range: the club has %d recordings", n, i)
+}
+
+// allRecordings lists every recording of a club, newest first
+func allRecordings(ctx context.Context, client *veo.Client, clubSlug string) ([]veo.Recording, error) {
+	result, err := client.ListRecordings(ctx, clubSlug, &veo.ListRecordingsOptions{FetchAll: true, Concurrency: defaultPageWorkers})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list recordings: %w", err)
+	}
+	return result.Recordings, nil
+}
+
+// onlyMatch returns the single recording in matches, nil if there are none,
+// or an *ambiguousSelectorError if there are several
+func onlyMatch(selector string, matches []veo.Recording) (*veo.Recording, error) {
+	switch len(matches) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &matches[0], nil
+	}
+	return nil, &ambiguousSelectorError{selector: selector, matches: matches}
+}
+
+// matchTitle returns the recordings whose title contains every word of
+// query, ignoring case and punctuation. A title equal to the query wins
+// over partial matches.
+func matchTitle(recordings []veo.Recording, query string) []veo.Recording {
+	words := strings.Fields(normalizeTitle(query))
+	if len(words) == 0 {
+		return nil
+	}
+
+	var matches []veo.Recording
+	for _, r := range recordings {
+		title := normalizeTitle(r.Title)
+		if title == strings.Join(words, " ") {
+			return []veo.Recording{r}
+		}
+
+		found := true
+		for _, word := range words {
+			if !strings.Contains(title, word) {
+				found = false
+				break
+			}
+		}
+		if found {
+			matches = append(matches, r)
+		}
+	}
+	return matches
+}
+
+// normalizeTitle lowercases a title and replaces punctuation with spaces
+func normalizeTitle(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return ' '
+	}, s)
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// resolveRecordingArg resolves a command's optional recording argument with
+// selectRecording. On a terminal, a missing or ambiguous selector opens a
+// picker instead of failing.
+func resolveRecordingArg(cmd *cobra.Command, client *veo.Client, s *settings, args []string) (*selection, error) {
+	ctx := cmd.Context()
+	pick := terminalPicker(cmd)
+
+	if len(args) == 0 {
+		if pick == nil {
+			return nil, fmt.Errorf("a recording argument is required (see 'veo help selectors')")
+		}
+		if err := s.requireClub(); err != nil {
+			return nil, err
+		}
+		recordings, err := allRecordings(ctx, client, s.Club)
+		if err != nil {
+			return nil, err
+		}
+		if len(recordings) == 0 {
+			return nil, fmt.Errorf("no recordings found")
+		}
+		return pickRecording(pick, recordings)
+	}
+
+	sel, err := selectRecording(ctx, client, s, args[0])
+	var ambiguous *ambiguousSelectorError
+	if pick != nil && errors.As(err, &ambiguous) {
+		return pickRecording(pick, ambiguous.matches)
+	}
+	return sel, err
+}
+
+// pickRecording returns the recording chosen with pick
+func pickRecording(pick pickFunc, recordings []veo.Recording) (*selection, error) {
+	r, err := pick(recordings)
+	if err != nil {
+		return nil, err
+	}
+	return &selection{id: r.Identifier}, nil
+}
+
+// printTitleMatch reports the recording a title selector matched, so that
+// commands changing data show which recording they change
+func printTitleMatch(w io.Writer, sel *selection) {
+	if r := sel.byTitle; r != nil {
+		fmt.Fprintf(w, "Matched by title: %s  %s  %s\n", r.Slug, formatLocalTime(r.Start), r.Title)
+	}
+}
+
+// resolveRecording resolves a command's recording argument and fetches its
+// details, unless they were already fetched while resolving
+func resolveRecording(cmd *cobra.Command, client *veo.Client, s *settings, args []string) (*veo.RecordingDetails, error) {
+	sel, err := resolveRecordingArg(cmd, client, s, args)
+	if err != nil {
+		return nil, err
+	}
+	if sel.details != nil {
+		return sel.details, nil
+	}
+
+	details, err := client.GetRecording(cmd.Context(), sel.id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get recording: %w", err)
+	}
+
+	return details, nil
+}
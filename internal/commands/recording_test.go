@@ -0,0 +1,202 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/justincampbell/veo"
+)
+
+// selectorRecordings are served newest first, over two pages
+var selectorRecordings = []string{
+	`{"identifier": "id-4", "slug": "20251123-match-rivals-fc-d4", "title": "Match - Rivals FC", "start": "2025-11-23T12:00:00Z", "created": "2025-11-23T14:00:00Z"}`,
+	`{"identifier": "id-3", "slug": "20251116-match-city-united-c3", "title": "Match - City United", "start": "2025-11-16T12:00:00Z", "created": "2025-11-16T14:00:00Z"}`,
+	`{"identifier": "id-2", "slug": "20251109-match-town-united-b2", "title": "Match - Town United", "start": "2025-11-09T12:00:00Z", "created": "2025-11-09T14:00:00Z"}`,
+	`{"identifier": "id-1", "slug": "20251109-training-a1", "title": "Training", "start": "2025-11-09T09:00:00Z", "created": "2025-11-09T11:00:00Z"}`,
+}
+
+// sharedRecording can be fetched by slug but isn't listed, like a recording
+// shared with the club
+const sharedRecording = `{"identifier": "shared-id", "slug": "20240101-shared-match-e5", "title": "Shared Match"}`
+
+// newSelectorServer serves selectorRecordings and sharedRecording, counting
+// requests in *requests if it isn't nil
+func newSelectorServer(t *testing.T, requests ...*int) *veo.Client {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(requests) > 0 {
+			*requests[0]++
+		}
+
+		if slug, ok := strings.CutPrefix(r.URL.Path, "/matches/"); ok {
+			slug = strings.TrimSuffix(slug, "/")
+			for _, recording := range append(selectorRecordings, sharedRecording) {
+				if strings.Contains(recording, `"slug": "`+slug+`"`) {
+					fmt.Fprint(w, recording)
+					return
+				}
+			}
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if r.URL.Query().Get("page") == "2" {
+			fmt.Fprintf(w, "[%s]", strings.Join(selectorRecordings[2:], ","))
+			return
+		}
+		w.Header().Set("Link", `</clubs/test-club/recordings/?page=2>; rel="next"`)
+		fmt.Fprintf(w, "[%s]", strings.Join(selectorRecordings[:2], ","))
+	}))
+	t.Cleanup(server.Close)
+
+	return veo.NewClient(veo.WithBaseURL(server.URL), veo.WithAuthToken("test-token"))
+}
+
+func TestSelectRecording(t *testing.T) {
+	client := newSelectorServer(t)
+	s := &settings{Club: "test-club"}
+
+	tests := []struct {
+		selector string
+		expected string
+	}{
+		{"latest", "id-4"},
+		{"latest~1", "id-3"},
+		{"latest~3", "id-1"},
+		{"first", "id-1"},
+		{"2025-11-16", "id-3"},
+		{"20251123", "id-4"},
+		{"20251109-training", "id-1"},
+		{"20251116-match-city-united-c3", "id-3"},
+		{"rivals", "id-4"},
+		{"city UNITED", "id-3"},
+		{"training", "id-1"},
+		{"7b9e6f0a-1c2d-4e5f-8a9b-0c1d2e3f4a5b", "7b9e6f0a-1c2d-4e5f-8a9b-0c1d2e3f4a5b"},
+		{"20240101-shared-match-e5", "shared-id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.selector, func(t *testing.T) {
+			sel, err := selectRecording(context.Background(), client, s, tt.selector)
+			if err != nil {
+				t.Fatalf("selectRecording(%q) failed: %v", tt.selector, err)
+			}
+			if sel.id != tt.expected {
+				t.Errorf("selectRecording(%q) = %q, expected %q", tt.selector, sel.id, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSelectRecordingAmbiguous(t *testing.T) {
+	client := newSelectorServer(t)
+	s := &settings{Club: "test-club"}
+
+	for _, selector := range []string{"2025-11-09", "20251109", "united"} {
+		t.Run(selector, func(t *testing.T) {
+			_, err := selectRecording(context.Background(), client, s, selector)
+
+			var ambiguous *ambiguousSelectorError
+			if !errors.As(err, &ambiguous) {
+				t.Fatalf("expected an ambiguous selector error, got %v", err)
+			}
+			if len(ambiguous.matches) != 2 {
+				t.Errorf("expected 2 matches, got %d", len(ambiguous.matches))
+			}
+			for _, r := range ambiguous.matches {
+				if !strings.Contains(err.Error(), r.Slug) {
+					t.Errorf("expected error to list %s, got:\n%s", r.Slug, err)
+				}
+			}
+		})
+	}
+}
+
+func TestSelectRecordingErrors(t *testing.T) {
+	client := newSelectorServer(t)
+
+	tests := []struct {
+		name     string
+		club     string
+		selector string
+		expected string
+	}{
+		{"out of range", "test-club", "latest~4", "out of range"},
+		{"no match", "test-club", "wanderers", `no recording matches "wanderers"`},
+		{"no date match", "test-club", "2025-11-10", `no recording matches "2025-11-10"`},
+		{"no club", "", "latest", "club is required"},
+		{"unknown slug", "test-club", "20240101-unknown-match", `no recording matches "20240101-unknown-match"`},
+		{"unknown slug without a club", "", "20240101-unknown-match", "failed to get recording"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &settings{Club: tt.club}
+			_, err := selectRecording(context.Background(), client, s, tt.selector)
+			if err == nil || !strings.Contains(err.Error(), tt.expected) {
+				t.Errorf("expected error containing %q, got %v", tt.expected, err)
+			}
+		})
+	}
+}
+
+func TestSelectRecordingFullSlug(t *testing.T) {
+	var requests int
+	client := newSelectorServer(t, &requests)
+
+	// A complete slug is fetched directly, without listing the library
+	sel, err := selectRecording(context.Background(), client, &settings{Club: "test-club"}, "20251116-match-city-united-c3")
+	if err != nil {
+		t.Fatalf("selectRecording failed: %v", err)
+	}
+	if sel.id != "id-3" || sel.details == nil || sel.details.Title != "Match - City United" {
+		t.Errorf("expected id-3 with its details, got %+v", sel)
+	}
+	if requests != 1 {
+		t.Errorf("expected 1 request, got %d", requests)
+	}
+
+	// Without a club it still works
+	sel, err = selectRecording(context.Background(), client, &settings{}, "20240101-shared-match-e5")
+	if err != nil || sel.id != "shared-id" {
+		t.Errorf("expected shared-id, got %+v, %v", sel, err)
+	}
+}
+
+func TestSelectRecordingByTitle(t *testing.T) {
+	client := newSelectorServer(t)
+	s := &settings{Club: "test-club"}
+
+	sel, err := selectRecording(context.Background(), client, s, "rivals")
+	if err != nil {
+		t.Fatalf("selectRecording failed: %v", err)
+	}
+	if sel.byTitle == nil || sel.byTitle.Identifier != "id-4" {
+		t.Errorf("expected a title match for id-4, got %+v", sel.byTitle)
+	}
+
+	var out strings.Builder
+	printTitleMatch(&out, sel)
+	if !strings.Contains(out.String(), "20251123-match-rivals-fc-d4") || !strings.Contains(out.String(), "Match - Rivals FC") {
+		t.Errorf("expected the matched recording to be printed, got %q", out.String())
+	}
+
+	// Other selectors aren't reported
+	for _, selector := range []string{"latest", "20251123", "2025-11-16"} {
+		sel, err := selectRecording(context.Background(), client, s, selector)
+		if err != nil {
+			t.Fatalf("selectRecording(%q) failed: %v", selector, err)
+		}
+		out.Reset()
+		printTitleMatch(&out, sel)
+		if out.Len() != 0 {
+			t.Errorf("expected nothing printed for %q, got %q", selector, out.String())
+		}
+	}
+}
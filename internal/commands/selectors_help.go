@@ -0,0 +1,39 @@
+package commands
+
+import "github.com/spf13/cobra"
+
+// NewSelectorsHelpCmd creates the "selectors" help topic for recording
+// arguments
+func NewSelectorsHelpCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "selectors",
+		Short: "Ways to pick a recording",
+		Long: `The get, update, highlights, videos, periods and download commands take a
+recording, which can be given as:
+
+  <recording-id>   The recording's ID
+  latest           The most recent recording
+  latest~N         The Nth recording before the most recent, e.g. latest~1
+  first            The oldest recording
+  2025-11-16       The recording that started on that day
+  <slug>           A recording whose slug starts with this, e.g. 20251116
+  <title>          A recording whose title contains these words, e.g. the
+                   opponent's name
+
+A complete slug is fetched directly. Everything else, including a complete
+slug that isn't found, lists the club's recordings, so needs --club, VEO_CLUB
+or a club in the config profile. If more than one recording matches, the
+matches are listed and nothing is done. When update or update sides picks a
+recording by its title, it prints which one before changing it.
+
+On a terminal, leaving out the recording, or giving a selector that matches
+several, opens a picker instead: type to search titles and dates, use the
+arrow keys to choose, Enter to confirm and Esc to cancel.
+
+Examples:
+
+  veo get latest~2
+  veo periods 2025-11-16
+  veo highlights "rivals fc" --tag goal`,
+	}
+}
@@ -0,0 +1,139 @@
+package commands
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"time"
+
+	"github.com/justincampbell/veo"
+	"github.com/justincampbell/veo/internal/config"
+	"github.com/spf13/cobra"
+)
+
+// settings holds the values a command runs with, resolved in order of
+// precedence: flags, environment variables, config profile, defaults
+type settings struct {
+	Profile     string
+	ConfigPath  string
+	Token       string
+	TokenSource string // Where Token came from, for auth status
+	CSRFToken   string
+	Club        string
+	BaseURL     string
+	Timezone    string
+	Output      string
+	Rate        float64 // Requests per second, 0 for unlimited
+}
+
+// loadSettings resolves settings for cmd. clubFlag is the value of the
+// command's --club flag, if it has one.
+func loadSettings(cmd *cobra.Command, clubFlag string) (*settings, error) {
+	profileName := selectedProfile(cmd)
+
+	path, cfg, err := loadConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := cfg.Profile(profileName)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &settings{
+		Profile:    cfg.ProfileName(profileName),
+		ConfigPath: path,
+		Token:      firstNonEmpty(os.Getenv("VEO_TOKEN"), profile.Token),
+		CSRFToken:  profile.CSRFToken,
+		Club:       firstNonEmpty(clubFlag, os.Getenv("VEO_CLUB"), profile.Club),
+		BaseURL:    firstNonEmpty(os.Getenv("VEO_BASE_URL"), profile.BaseURL),
+		Timezone:   firstNonEmpty(os.Getenv("TZ"), profile.Timezone),
+		Output:     firstNonEmpty(os.Getenv("VEO_OUTPUT"), profile.Output),
+	}
+
+	s.Rate, _ = cmd.Flags().GetFloat64("rate")
+
+	switch {
+	case os.Getenv("VEO_TOKEN") != "":
+		s.TokenSource = "VEO_TOKEN environment variable"
+	case profile.Token != "":
+		s.TokenSource = fmt.Sprintf("profile %q in %s", s.Profile, path)
+	}
+
+	// TZ is already applied by the runtime; only a profile timezone needs loading
+	if os.Getenv("TZ") == "" && s.Timezone != "" {
+		loc, err := time.LoadLocation(s.Timezone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid timezone %q in profile %q: %w", s.Timezone, s.Profile, err)
+		}
+		time.Local = loc
+	}
+
+	return s, nil
+}
+
+// selectedProfile returns the profile chosen by --profile or VEO_PROFILE,
+// or "" to use the config's default
+func selectedProfile(cmd *cobra.Command) string {
+	if name, _ := cmd.Flags().GetString("profile"); name != "" {
+		return name
+	}
+	return os.Getenv("VEO_PROFILE")
+}
+
+// loadConfig loads the config file and returns it with its path
+func loadConfig() (string, *config.Config, error) {
+	path, err := config.Path()
+	if err != nil {
+		return "", nil, err
+	}
+
+	cfg, err := config.Load(path)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return path, cfg, nil
+}
+
+// requireToken returns an error if no auth token is configured
+func (s *settings) requireToken() error {
+	if s.Token == "" {
+		return &notLoggedInError{profile: s.Profile}
+	}
+	return nil
+}
+
+// requireClub returns an error if no club is configured
+func (s *settings) requireClub() error {
+	if s.Club == "" {
+		return fmt.Errorf("--club flag, VEO_CLUB environment variable, or club in config profile %q is required", s.Profile)
+	}
+	return nil
+}
+
+// newClient creates an API client from the settings
+func (s *settings) newClient() *veo.Client {
+	opts := []veo.ClientOption{veo.WithAuthToken(s.Token)}
+	if s.BaseURL != "" {
+		opts = append(opts, veo.WithBaseURL(s.BaseURL))
+	}
+	if s.CSRFToken != "" {
+		opts = append(opts, veo.WithCSRFToken(s.CSRFToken))
+	}
+	if s.Rate > 0 {
+		opts = append(opts, veo.WithRateLimit(s.Rate, int(math.Ceil(s.Rate))))
+	}
+	return veo.NewClient(opts...)
+}
+
+// firstNonEmpty returns the first non-empty value
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,82 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestLoadSettingsPrecedence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	data := `default_profile: home
+profiles:
+  home:
+    token: home-token
+    club: home-club
+  away:
+    token: away-token
+    club: away-club
+    output: json
+`
+	if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("VEO_CONFIG", path)
+	t.Setenv("VEO_TOKEN", "")
+	t.Setenv("VEO_CLUB", "")
+	t.Setenv("VEO_PROFILE", "")
+	t.Setenv("VEO_OUTPUT", "")
+
+	newCmd := func(profile string) *cobra.Command {
+		cmd := &cobra.Command{}
+		cmd.Flags().String("profile", "", "")
+		cmd.Flags().Set("profile", profile)
+		return cmd
+	}
+
+	// Default profile from config
+	s, err := loadSettings(newCmd(""), "")
+	if err != nil {
+		t.Fatalf("loadSettings failed: %v", err)
+	}
+	if s.Token != "home-token" || s.Club != "home-club" {
+		t.Errorf("expected home profile, got %+v", s)
+	}
+
+	// Named profile
+	s, err = loadSettings(newCmd("away"), "")
+	if err != nil {
+		t.Fatalf("loadSettings failed: %v", err)
+	}
+	if s.Club != "away-club" || s.Output != "json" {
+		t.Errorf("expected away profile, got %+v", s)
+	}
+
+	// Environment overrides profile
+	t.Setenv("VEO_CLUB", "env-club")
+	t.Setenv("VEO_TOKEN", "env-token")
+	s, err = loadSettings(newCmd("away"), "")
+	if err != nil {
+		t.Fatalf("loadSettings failed: %v", err)
+	}
+	if s.Club != "env-club" || s.Token != "env-token" {
+		t.Errorf("expected environment values, got %+v", s)
+	}
+
+	// Flag overrides environment
+	s, err = loadSettings(newCmd("away"), "flag-club")
+	if err != nil {
+		t.Fatalf("loadSettings failed: %v", err)
+	}
+	if s.Club != "flag-club" {
+		t.Errorf("expected flag club, got %q", s.Club)
+	}
+
+	// Unknown profile is an error
+	if _, err := loadSettings(newCmd("missing"), ""); err == nil {
+		t.Error("expected error for unknown profile")
+	}
+}
@@ -0,0 +1,327 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/justincampbell/veo"
+	"github.com/justincampbell/veo/internal/download"
+	"github.com/spf13/cobra"
+)
+
+// syncStateFile is the name of the state file in the mirror directory
+const syncStateFile = ".veo-sync.json"
+
+// syncState records what has been mirrored, keyed by recording slug
+type syncState struct {
+	Recordings map[string]syncEntry `json:"recordings"`
+}
+
+// syncEntry holds the fields used to detect changed recordings
+type syncEntry struct {
+	Identifier string    `json:"identifier"`
+	Title      string    `json:"title"`
+	Created    time.Time `json:"created"`
+	Duration   int       `json:"duration"`
+	SyncedAt   time.Time `json:"synced_at"`
+	Reel       bool      `json:"reel,omitempty"` // Synced with --reel, so the reel (if any) was downloaded
+}
+
+// NewSyncCmd creates the sync command
+func NewSyncCmd() *cobra.Command {
+	var clubSlug string
+	var withReel bool
+	var prune bool
+
+	cmd := &cobra.Command{
+		Use:   "sync <dir>",
+		Short: "Mirror the club's recordings to a local directory",
+		Long: `Mirror every recording of the club into <dir>/<slug>/, containing
+match.json, periods.json, highlights.json, the thumbnail and, with --reel,
+the reel video.
+
+Repeat runs only fetch recordings that are new or whose title, duration or
+creation time changed, and with --reel those synced before without it.
+Recordings deleted upstream are reported; pass --prune to remove their local
+copies.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			s, err := loadSettings(cmd, clubSlug)
+			if err != nil {
+				return err
+			}
+			if err := s.requireToken(); err != nil {
+				return err
+			}
+			if err := s.requireClub(); err != nil {
+				return err
+			}
+
+			// Create API client
+			client := s.newClient()
+
+			m := &mirror{
+				client:   client,
+				dir:      args[0],
+				d:        download.New(),
+				withReel: withReel,
+			}
+			m.d.SkipExisting = true
+
+			return m.sync(cmd.Context(), s.Club, prune)
+		},
+	}
+
+	cmd.Flags().StringVarP(&clubSlug, "club", "c", "", "Club slug (or set VEO_CLUB or a config profile)")
+	cmd.Flags().BoolVar(&withReel, "reel", false, "Also download each recording's reel")
+	cmd.Flags().BoolVar(&prune, "prune", false, "Remove local copies of recordings deleted upstream")
+
+	return cmd
+}
+
+// mirror syncs recordings into a local directory
+type mirror struct {
+	client   *veo.Client
+	dir      string
+	d        *download.Downloader
+	withReel bool
+}
+
+// sync mirrors all recordings of a club and prints a summary
+func (m *mirror) sync(ctx context.Context, clubSlug string, prune bool) error {
+	if err := os.MkdirAll(m.dir, 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", m.dir, err)
+	}
+
+	state, err := m.loadState()
+	if err != nil {
+		return err
+	}
+
+	result, err := m.client.ListRecordings(ctx, clubSlug, &veo.ListRecordingsOptions{FetchAll: true, Concurrency: defaultPageWorkers})
+	if err != nil {
+		return fmt.Errorf("failed to list recordings: %w", err)
+	}
+
+	var added, updated, unchanged, failed int
+	upstream := make(map[string]bool)
+
+	for _, r := range result.Recordings {
+		if err := ctx.Err(); err != nil {
+			return fmt.Errorf("sync canceled: %w", err)
+		}
+
+		upstream[r.Slug] = true
+
+		entry, seen := state.Recordings[r.Slug]
+		if seen && !recordingChanged(entry, r) && (entry.Reel || !m.withReel) {
+			unchanged++
+			continue
+		}
+
+		if err := m.syncRecording(ctx, r); err != nil {
+			failed++
+			fmt.Fprintf(os.Stderr, "Failed %s: %v\n", r.Slug, err)
+			continue
+		}
+
+		state.Recordings[r.Slug] = syncEntry{
+			Identifier: r.Identifier,
+			Title:      r.Title,
+			Created:    r.Created,
+			Duration:   r.Duration,
+			SyncedAt:   time.Now(),
+			Reel:       m.withReel,
+		}
+		// Save after each recording so an interrupted sync keeps its progress
+		if err := m.saveState(state); err != nil {
+			return err
+		}
+
+		if seen {
+			updated++
+			fmt.Fprintf(os.Stderr, "Updated %s\n", r.Slug)
+		} else {
+			added++
+			fmt.Fprintf(os.Stderr, "Added   %s\n", r.Slug)
+		}
+	}
+
+	deleted := deletedUpstream(state, upstream)
+	for _, slug := range deleted {
+		if !prune {
+			fmt.Fprintf(os.Stderr, "Deleted upstream: %s (use --prune to remove)\n", slug)
+			continue
+		}
+		dir, err := m.recordingDir(slug)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Not pruning: %v\n", err)
+			continue
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			return fmt.Errorf("failed to prune %s: %w", slug, err)
+		}
+		delete(state.Recordings, slug)
+		fmt.Fprintf(os.Stderr, "Pruned  %s\n", slug)
+	}
+	if prune && len(deleted) > 0 {
+		if err := m.saveState(state); err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(os.Stderr, "\nAdded: %d, Updated: %d, Unchanged: %d, Deleted upstream: %d, Failed: %d\n",
+		added, updated, unchanged, len(deleted), failed)
+
+	if failed > 0 {
+		return fmt.Errorf("%d recordings failed to sync", failed)
+	}
+
+	return nil
+}
+
+// syncRecording writes all files for one recording
+func (m *mirror) syncRecording(ctx context.Context, r veo.Recording) error {
+	dir, err := m.recordingDir(r.Slug)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	details, err := m.client.GetRecording(ctx, r.Identifier)
+	if err != nil {
+		return fmt.Errorf("failed to get recording: %w", err)
+	}
+	if err := writeJSONFile(filepath.Join(dir, "match.json"), details); err != nil {
+		return err
+	}
+
+	periods, err := m.client.GetPeriods(ctx, r.Slug)
+	if err != nil {
+		return fmt.Errorf("failed to get periods: %w", err)
+	}
+	if err := writeJSONFile(filepath.Join(dir, "periods.json"), periods); err != nil {
+		return err
+	}
+
+	highlights, err := m.client.ListHighlights(ctx, r.Slug, nil)
+	if err != nil {
+		return fmt.Errorf("failed to list highlights: %w", err)
+	}
+	if err := writeJSONFile(filepath.Join(dir, "highlights.json"), highlights); err != nil {
+		return err
+	}
+
+	if r.Thumbnail != "" {
+		name := "thumbnail" + thumbnailExt(r.Thumbnail)
+		if _, err := m.d.Download(ctx, r.Thumbnail, filepath.Join(dir, name)); err != nil {
+			return fmt.Errorf("failed to download thumbnail: %w", err)
+		}
+	}
+
+	if m.withReel && details.ReelURL != "" {
+		if _, err := m.d.Download(ctx, details.ReelURL, filepath.Join(dir, "reel.mp4")); err != nil {
+			return fmt.Errorf("failed to download reel: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// recordingDir returns the directory of a recording in the mirror. Slugs come
+// from the server and the state file, so they are checked to name a single
+// directory inside the mirror before being used as a path. Slugs starting
+// with a dot (including "." and "..") are rejected too, so they can't clash
+// with the state file.
+func (m *mirror) recordingDir(slug string) (string, error) {
+	if slug == "" || strings.HasPrefix(slug, ".") || strings.ContainsAny(slug, `/\`) || !filepath.IsLocal(slug) {
+		return "", fmt.Errorf("unsafe recording slug %q", slug)
+	}
+
+	dir := filepath.Join(m.dir, slug)
+	rel, err := filepath.Rel(m.dir, dir)
+	if err != nil || rel != slug {
+		return "", fmt.Errorf("unsafe recording slug %q", slug)
+	}
+
+	return dir, nil
+}
+
+// loadState reads the state file, or returns an empty state
+func (m *mirror) loadState() (*syncState, error) {
+	state := &syncState{Recordings: make(map[string]syncEntry)}
+
+	data, err := os.ReadFile(filepath.Join(m.dir, syncStateFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return state, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read sync state: %w", err)
+	}
+
+	if err := json.Unmarshal(data, state); err != nil {
+		return nil, fmt.Errorf("failed to parse sync state: %w", err)
+	}
+	if state.Recordings == nil {
+		state.Recordings = make(map[string]syncEntry)
+	}
+
+	return state, nil
+}
+
+// saveState writes the state file
+func (m *mirror) saveState(state *syncState) error {
+	return writeJSONFile(filepath.Join(m.dir, syncStateFile), state)
+}
+
+// recordingChanged reports whether a recording differs from its synced state
+func recordingChanged(entry syncEntry, r veo.Recording) bool {
+	return entry.Title != r.Title ||
+		entry.Duration != r.Duration ||
+		!entry.Created.Equal(r.Created)
+}
+
+// deletedUpstream returns the synced slugs missing from upstream, sorted
+func deletedUpstream(state *syncState, upstream map[string]bool) []string {
+	var deleted []string
+	for slug := range state.Recordings {
+		if !upstream[slug] {
+			deleted = append(deleted, slug)
+		}
+	}
+	sort.Strings(deleted)
+	return deleted
+}
+
+// thumbnailExt returns the file extension of a thumbnail URL
+func thumbnailExt(rawURL string) string {
+	if u, err := url.Parse(rawURL); err == nil {
+		if ext := path.Ext(u.Path); ext != "" && len(ext) <= 5 {
+			return ext
+		}
+	}
+	return ".jpg"
+}
+
+// writeJSONFile writes v as indented JSON
+func writeJSONFile(path string, v interface{}) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", filepath.Base(path), err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,235 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/justincampbell/veo"
+	"github.com/justincampbell/veo/internal/download"
+)
+
+// fakeLibrary serves a mutable set of recordings
+type fakeLibrary struct {
+	mu        sync.Mutex
+	titles    map[string]string // slug -> title
+	getCounts map[string]int    // slug -> match detail requests
+	serverURL string
+}
+
+func (f *fakeLibrary) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case r.URL.Path == "/clubs/test-club/recordings/":
+		var items []string
+		for _, slug := range []string{"match-a", "match-b"} {
+			if title, ok := f.titles[slug]; ok {
+				items = append(items, fmt.Sprintf(
+					`{"identifier": %q, "slug": %q, "title": %q, "duration": 100, "created": "2025-11-01T00:00:00Z", "thumbnail": "%s/thumbs/%s.png?v=1"}`,
+					slug, slug, title, f.serverURL, slug))
+			}
+		}
+		fmt.Fprintf(w, "[%s]", strings.Join(items, ","))
+	case strings.HasSuffix(r.URL.Path, "/periods/"):
+		w.Write([]byte(`[{"name": "1st half", "timeframe": [60, 1800]}]`))
+	case strings.HasSuffix(r.URL.Path, "/highlights/"):
+		w.Write([]byte(`[{"id": "h1", "tags": ["goal"]}]`))
+	case strings.HasPrefix(r.URL.Path, "/matches/"):
+		slug := strings.Trim(strings.TrimPrefix(r.URL.Path, "/matches/"), "/")
+		f.getCounts[slug]++
+		fmt.Fprintf(w, `{"identifier": %q, "slug": %q, "title": %q, "reel_url": "%s/reels/%s.mp4"}`,
+			slug, slug, f.titles[slug], f.serverURL, slug)
+	case strings.HasPrefix(r.URL.Path, "/thumbs/"):
+		w.Write([]byte("png"))
+	case strings.HasPrefix(r.URL.Path, "/reels/"):
+		w.Write([]byte("mp4"))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestMirrorSync(t *testing.T) {
+	lib := &fakeLibrary{
+		titles:    map[string]string{"match-a": "Match A", "match-b": "Match B"},
+		getCounts: make(map[string]int),
+	}
+	server := httptest.NewServer(lib)
+	defer server.Close()
+	lib.serverURL = server.URL
+
+	dir := t.TempDir()
+	m := &mirror{
+		client: veo.NewClient(veo.WithBaseURL(server.URL), veo.WithAuthToken("test-token")),
+		dir:    dir,
+		d:      download.New(),
+	}
+	m.d.SkipExisting = true
+
+	// First run mirrors everything
+	if err := m.sync(context.Background(), "test-club", false); err != nil {
+		t.Fatalf("first sync failed: %v", err)
+	}
+	for _, name := range []string{"match.json", "periods.json", "highlights.json", "thumbnail.png"} {
+		if _, err := os.Stat(filepath.Join(dir, "match-a", name)); err != nil {
+			t.Errorf("expected %s to be written: %v", name, err)
+		}
+	}
+
+	var details veo.RecordingDetails
+	data, _ := os.ReadFile(filepath.Join(dir, "match-a", "match.json"))
+	if err := json.Unmarshal(data, &details); err != nil || details.Title != "Match A" {
+		t.Errorf("unexpected match.json: %s", data)
+	}
+
+	// Second run fetches nothing new
+	if err := m.sync(context.Background(), "test-club", false); err != nil {
+		t.Fatalf("second sync failed: %v", err)
+	}
+	if lib.getCounts["match-a"] != 1 || lib.getCounts["match-b"] != 1 {
+		t.Errorf("expected unchanged recordings not to be refetched, got %v", lib.getCounts)
+	}
+
+	// A retitled recording is refetched; a deleted one is kept without --prune
+	lib.mu.Lock()
+	lib.titles["match-a"] = "Match A (renamed)"
+	delete(lib.titles, "match-b")
+	lib.mu.Unlock()
+
+	if err := m.sync(context.Background(), "test-club", false); err != nil {
+		t.Fatalf("third sync failed: %v", err)
+	}
+	if lib.getCounts["match-a"] != 2 {
+		t.Errorf("expected changed recording to be refetched, got %d", lib.getCounts["match-a"])
+	}
+	if _, err := os.Stat(filepath.Join(dir, "match-b")); err != nil {
+		t.Error("expected deleted recording to be kept without --prune")
+	}
+
+	// --prune removes it
+	if err := m.sync(context.Background(), "test-club", true); err != nil {
+		t.Fatalf("prune sync failed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "match-b")); !os.IsNotExist(err) {
+		t.Error("expected deleted recording to be pruned")
+	}
+
+	state, err := m.loadState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := state.Recordings["match-b"]; ok {
+		t.Error("expected pruned recording to be removed from state")
+	}
+}
+
+func TestMirrorSyncReelLater(t *testing.T) {
+	lib := &fakeLibrary{
+		titles:    map[string]string{"match-a": "Match A"},
+		getCounts: make(map[string]int),
+	}
+	server := httptest.NewServer(lib)
+	defer server.Close()
+	lib.serverURL = server.URL
+
+	dir := t.TempDir()
+	m := &mirror{
+		client: veo.NewClient(veo.WithBaseURL(server.URL), veo.WithAuthToken("test-token")),
+		dir:    dir,
+		d:      download.New(),
+	}
+	m.d.SkipExisting = true
+	reel := filepath.Join(dir, "match-a", "reel.mp4")
+
+	if err := m.sync(context.Background(), "test-club", false); err != nil {
+		t.Fatalf("sync without --reel failed: %v", err)
+	}
+	if _, err := os.Stat(reel); !os.IsNotExist(err) {
+		t.Fatal("expected no reel without --reel")
+	}
+
+	// An unchanged recording synced without --reel is fetched again for it
+	m.withReel = true
+	if err := m.sync(context.Background(), "test-club", false); err != nil {
+		t.Fatalf("sync with --reel failed: %v", err)
+	}
+	if _, err := os.Stat(reel); err != nil {
+		t.Errorf("expected reel to be downloaded: %v", err)
+	}
+
+	// Once it has the reel, it is unchanged again
+	if err := m.sync(context.Background(), "test-club", false); err != nil {
+		t.Fatalf("repeat sync with --reel failed: %v", err)
+	}
+	if lib.getCounts["match-a"] != 2 {
+		t.Errorf("expected 2 detail requests, got %d", lib.getCounts["match-a"])
+	}
+}
+
+func TestMirrorRecordingDir(t *testing.T) {
+	m := &mirror{dir: t.TempDir()}
+
+	if dir, err := m.recordingDir("20251116-match"); err != nil || dir != filepath.Join(m.dir, "20251116-match") {
+		t.Errorf("expected a directory in the mirror, got %q, %v", dir, err)
+	}
+
+	for _, slug := range []string{"", ".", "..", "../outside", "a/b", `a\b`, "/etc", ".veo-sync.json"} {
+		if dir, err := m.recordingDir(slug); err == nil {
+			t.Errorf("recordingDir(%q) = %q, expected an error", slug, dir)
+		}
+	}
+}
+
+func TestMirrorPruneUnsafeSlugs(t *testing.T) {
+	lib := &fakeLibrary{
+		titles:    map[string]string{"match-a": "Match A"},
+		getCounts: make(map[string]int),
+	}
+	server := httptest.NewServer(lib)
+	defer server.Close()
+	lib.serverURL = server.URL
+
+	root := t.TempDir()
+	dir := filepath.Join(root, "mirror")
+	outside := filepath.Join(root, "outside")
+	if err := os.MkdirAll(outside, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	m := &mirror{
+		client: veo.NewClient(veo.WithBaseURL(server.URL), veo.WithAuthToken("test-token")),
+		dir:    dir,
+		d:      download.New(),
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	// A tampered state file with slugs that point at the mirror or outside it
+	state := &syncState{Recordings: map[string]syncEntry{}}
+	for _, slug := range []string{"", ".", "..", "../outside"} {
+		state.Recordings[slug] = syncEntry{}
+	}
+	if err := m.saveState(state); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := m.sync(context.Background(), "test-club", true); err != nil {
+		t.Fatalf("sync failed: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "match-a", "match.json")); err != nil {
+		t.Errorf("expected the mirror to be kept: %v", err)
+	}
+	if _, err := os.Stat(outside); err != nil {
+		t.Errorf("expected the directory outside the mirror to be kept: %v", err)
+	}
+}
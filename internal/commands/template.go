@@ -0,0 +1,121 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/justincampbell/veo"
+)
+
+// formatTemplate is the output format used with --format
+const formatTemplate = "template"
+
+// parseFormatTemplate parses a --format template. Helpers are bound to the
+// API when it is executed.
+func parseFormatTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("format").Funcs(templateFuncs(nil)).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --format template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// executeTemplate renders each element of a slice through tmpl, or data
+// itself if it isn't a slice, each followed by a newline
+func executeTemplate(w io.Writer, tmpl *template.Template, data any) error {
+	items := []any{data}
+	if v := reflect.ValueOf(data); v.Kind() == reflect.Slice {
+		items = make([]any, v.Len())
+		for i := range items {
+			items[i] = v.Index(i).Interface()
+		}
+	}
+
+	for _, item := range items {
+		if err := tmpl.Execute(w, item); err != nil {
+			return fmt.Errorf("failed to render --format template: %w", err)
+		}
+		fmt.Fprintln(w)
+	}
+	return nil
+}
+
+// templateFuncs returns the helper functions available in --format
+// templates. periods looks up a match's periods for kickoff and shareURL;
+// it is nil while parsing.
+func templateFuncs(periods func(slug string) []veo.Period) template.FuncMap {
+	return template.FuncMap{
+		// duration formats seconds as HH:MM:SS
+		"duration": func(seconds any) (string, error) {
+			n, err := toSeconds(seconds)
+			return formatDuration(n), err
+		},
+		// timestamp formats seconds as MM:SS, e.g. a highlight's start
+		"timestamp": func(seconds any) (string, error) {
+			n, err := toSeconds(seconds)
+			return formatTimestamp(n), err
+		},
+		// local converts a time to the local timezone, for use with .Format
+		"local": func(t time.Time) time.Time { return t.Local() },
+		// date formats a time as YYYY-MM-DD HH:MM in the local timezone
+		"date": formatLocalTime,
+		// kickoff returns the kickoff timestamp (MM:SS) of a match by slug
+		"kickoff": func(slug string) string {
+			p := periods(slug)
+			if len(p) == 0 || len(p[0].Timeframe) == 0 {
+				return ""
+			}
+			return formatTimestamp(p[0].Timeframe[0])
+		},
+		// shareURL returns the app URL of a match by slug, starting at kickoff
+		"shareURL": func(slug string) string { return shareURL(slug, periods(slug)) },
+		"json": func(v any) (string, error) {
+			data, err := json.Marshal(v)
+			return string(data), err
+		},
+		"join":     strings.Join,
+		"upper":    strings.ToUpper,
+		"lower":    strings.ToLower,
+		"truncate": func(maxLen int, s string) string { return truncateString(s, maxLen) },
+	}
+}
+
+// toSeconds converts an integer or float number of seconds to an int
+func toSeconds(v any) (int, error) {
+	value := reflect.ValueOf(v)
+	switch {
+	case value.CanInt():
+		return int(value.Int()), nil
+	case value.CanFloat():
+		return int(value.Float()), nil
+	}
+	return 0, fmt.Errorf("expected a number of seconds, got %T", v)
+}
+
+// periodsCache fetches match periods for templates, once per match. Errors
+// are treated as no periods, so helpers fall back as "get" does.
+type periodsCache struct {
+	ctx    context.Context
+	client *veo.Client
+	bySlug map[string][]veo.Period
+}
+
+// get returns the periods of the match with slug
+func (c *periodsCache) get(slug string) []veo.Period {
+	if periods, ok := c.bySlug[slug]; ok {
+		return periods
+	}
+	if c.bySlug == nil {
+		c.bySlug = make(map[string][]veo.Period)
+	}
+
+	periods, _ := c.client.GetPeriods(c.ctx, slug)
+	c.bySlug[slug] = periods
+	return periods
+}
@@ -0,0 +1,124 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/justincampbell/veo"
+	"github.com/spf13/cobra"
+)
+
+func TestFormatTemplate(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/matches/match-1/periods/" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		fmt.Fprint(w, `[{"name": "First half", "timeframe": [125, 1925]}]`)
+	}))
+	defer server.Close()
+
+	recordings := []veo.Recording{
+		{Slug: "match-1", Title: "Match", Duration: 3725, Start: time.Date(2025, 11, 16, 12, 0, 0, 0, time.Local)},
+		{Slug: "match-2", Title: "Training", Duration: 60, Start: time.Date(2025, 11, 9, 12, 0, 0, 0, time.Local)},
+	}
+
+	tests := []struct {
+		name     string
+		format   string
+		expected string
+	}{
+		{
+			name:     "fields and duration",
+			format:   `{{.Title}} {{duration .Duration}}`,
+			expected: "Match 01:02:05\nTraining 00:01:00\n",
+		},
+		{
+			name:     "dates",
+			format:   `{{date .Start}} {{(local .Start).Format "Jan 2"}}`,
+			expected: "2025-11-16 12:00 Nov 16\n2025-11-09 12:00 Nov 9\n",
+		},
+		{
+			name:     "kickoff and share URL",
+			format:   `{{kickoff .Slug}} {{shareURL .Slug}}`,
+			expected: "02:05 https://app.veo.co/matches/match-1/#t=02:05\n https://app.veo.co/matches/match-2/\n",
+		},
+		{
+			name:     "string helpers",
+			format:   `{{upper .Title | truncate 4}} {{json .Slug}}`,
+			expected: "M... \"match-1\"\nT... \"match-2\"\n",
+		},
+	}
+
+	client := veo.NewClient(veo.WithBaseURL(server.URL), veo.WithAuthToken("test-token"))
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			output := addOutputFlags(cmd)
+			if err := cmd.ParseFlags([]string{"--format", tt.format}); err != nil {
+				t.Fatalf("ParseFlags failed: %v", err)
+			}
+			format, err := output.resolve(cmd, &settings{})
+			if err != nil {
+				t.Fatalf("resolve failed: %v", err)
+			}
+
+			var buf bytes.Buffer
+			if err := output.write(context.Background(), &buf, client, format, recordings, nil); err != nil {
+				t.Fatalf("write failed: %v", err)
+			}
+			if buf.String() != tt.expected {
+				t.Errorf("unexpected output:\n%q\nexpected:\n%q", buf.String(), tt.expected)
+			}
+		})
+	}
+
+	// Periods are fetched once per match, even when used twice
+	if requests != 2 {
+		t.Errorf("expected 2 periods requests, got %d", requests)
+	}
+}
+
+func TestFormatTemplateSingleItem(t *testing.T) {
+	tmpl, err := parseFormatTemplate(`{{.Identifier}}: {{timestamp .Duration}}`)
+	if err != nil {
+		t.Fatalf("parseFormatTemplate failed: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := executeTemplate(&buf, tmpl, &veo.RecordingDetails{Identifier: "id1", Duration: 754}); err != nil {
+		t.Fatalf("executeTemplate failed: %v", err)
+	}
+	if buf.String() != "id1: 12:34\n" {
+		t.Errorf("unexpected output %q", buf.String())
+	}
+}
+
+func TestFormatConflictsWithOutput(t *testing.T) {
+	cmd := &cobra.Command{}
+	output := addOutputFlags(cmd)
+	if err := cmd.ParseFlags([]string{"--format", "{{.Title}}", "-o", "csv"}); err != nil {
+		t.Fatalf("ParseFlags failed: %v", err)
+	}
+	if _, err := output.resolve(cmd, &settings{}); err == nil {
+		t.Error("expected --format with --output to fail")
+	}
+}
+
+func TestToSeconds(t *testing.T) {
+	for _, v := range []any{90, int64(90), 90.7, float32(90)} {
+		if n, err := toSeconds(v); err != nil || n != 90 {
+			t.Errorf("toSeconds(%v) = %d, %v", v, n, err)
+		}
+	}
+	if _, err := toSeconds("90"); err == nil {
+		t.Error("expected error for a string")
+	}
+}
@@ -0,0 +1,44 @@
+package commands
+
+import "github.com/spf13/cobra"
+
+// NewTemplatesHelpCmd creates the "templates" help topic for --format
+func NewTemplatesHelpCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "templates",
+		Short: "Go templates for --format",
+		Long: `The list, get, update, highlights, videos and periods commands accept
+--format with a Go text/template (https://pkg.go.dev/text/template). It is
+rendered once per item, followed by a newline. Fields are those of the JSON
+output, by their Go names:
+
+  list         .Identifier .Slug .Title .Type .Start .Created .Duration
+               .Camera .Team .Privacy .IsAccessible .URL .Thumbnail .ReelURL
+  get, update  .Identifier .Slug .Title .Type .Start .End .Duration
+               .OwnTeamHomeOrAway .OpponentTeamName .OpponentClubName ...
+  highlights   .ID .Start .Duration .Tags .IsAIGenerated .Videos
+  videos       .ID .Kind .URL .Width .Height .Duration
+  periods      .Name .Timeframe .Duration .OwnSide .IsConfirmed
+
+Helper functions:
+
+  duration SECONDS     Format seconds as HH:MM:SS
+  timestamp SECONDS    Format seconds as MM:SS, e.g. a highlight's start
+  date TIME            Format a time as YYYY-MM-DD HH:MM in local time
+  local TIME           Convert a time to local time, e.g. (local .Start).Format "Jan 2"
+  kickoff SLUG         Kickoff timestamp (MM:SS) of a match
+  shareURL SLUG        App URL of a match, starting at kickoff
+  json VALUE           Encode a value as JSON
+  join LIST SEP        Join strings, e.g. join .Tags ","
+  upper, lower         Change case
+  truncate N STRING    Shorten a string to N characters
+
+kickoff and shareURL fetch the match's periods, one request per match.
+
+Examples:
+
+  veo list --all --format '{{date .Start}}  {{.Title}} ({{duration .Duration}})'
+  veo list --format '{{shareURL .Slug}}'
+  veo highlights latest --format '{{timestamp .Start}} {{join .Tags ","}}'`,
+	}
+}
@@ -2,19 +2,143 @@ package commands
 
 import (
 	"fmt"
+	"os"
+	"time"
 
+	"github.com/justincampbell/veo"
 	"github.com/spf13/cobra"
 )
 
+// Valid values for match fields, as listed in docs/api.md
+var (
+	validMatchTypes = []string{"match", "tournament", "training", "scrimmage"}
+	validHomeOrAway = []string{"home", "away"}
+)
+
 // NewUpdateCmd creates the update command
 func NewUpdateCmd() *cobra.Command {
+	var clubSlug string
+	var output *outputFlags
+	var title, matchType, homeOrAway, start string
+
 	cmd := &cobra.Command{
-		Use:   "update",
-		Short: "Update video metadata",
+		Use:   "update [recording-id|latest]",
+		Short: "Update match metadata",
+		Long: `Update the title, type, home/away side or start date of a match.
+
+Only the flags that are given are sent to the API. Use "latest" to update
+the most recent recording (see "veo help selectors"). Use "update sides" to
+change team details.`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return fmt.Errorf("not yet implemented - waiting for HAR analysis")
+			update := &veo.MatchUpdate{}
+
+			if cmd.Flags().Changed("title") {
+				update.Title = &title
+			}
+			if cmd.Flags().Changed("type") {
+				if !contains(validMatchTypes, matchType) {
+					return fmt.Errorf("invalid type %q (valid: %v)", matchType, validMatchTypes)
+				}
+				update.Type = &matchType
+			}
+			if cmd.Flags().Changed("home-away") {
+				if !contains(validHomeOrAway, homeOrAway) {
+					return fmt.Errorf("invalid home-away %q (valid: %v)", homeOrAway, validHomeOrAway)
+				}
+				update.OwnTeamHomeOrAway = &homeOrAway
+			}
+			if cmd.Flags().Changed("start") {
+				formatted, err := parseStart(start)
+				if err != nil {
+					return err
+				}
+				update.Start = &formatted
+			}
+
+			if *update == (veo.MatchUpdate{}) {
+				return fmt.Errorf("nothing to update: specify at least one of --title, --type, --home-away, --start")
+			}
+
+			s, err := loadSettings(cmd, clubSlug)
+			if err != nil {
+				return err
+			}
+			format, err := output.resolve(cmd, s)
+			if err != nil {
+				return err
+			}
+			if err := s.requireToken(); err != nil {
+				return err
+			}
+
+			// Create API client
+			client := s.newClient()
+
+			sel, err := resolveRecordingArg(cmd, client, s, args)
+			if err != nil {
+				return err
+			}
+			printTitleMatch(os.Stderr, sel)
+
+			details, err := client.UpdateMatch(cmd.Context(), sel.id, update)
+			if err != nil {
+				return fmt.Errorf("failed to update recording: %w", err)
+			}
+
+			if format == formatTable {
+				printRecordingDetails(details, nil)
+				return nil
+			}
+
+			return output.write(cmd.Context(), cmd.OutOrStdout(), client, format, details, detailsTable(details, nil))
 		},
 	}
 
+	cmd.Flags().StringVar(&title, "title", "", "New match title")
+	cmd.Flags().StringVar(&matchType, "type", "", "Match type (match, tournament, training, scrimmage)")
+	cmd.Flags().StringVar(&homeOrAway, "home-away", "", "Whether your team is home or away")
+	cmd.Flags().StringVar(&start, "start", "", "Match start (YYYY-MM-DD or YYYY-MM-DD HH:MM)")
+	cmd.Flags().StringVarP(&clubSlug, "club", "c", "", "Club slug (required to select recordings by other than ID, or set VEO_CLUB or a config profile)")
+	output = addOutputFlags(cmd)
+
+	cmd.AddCommand(newUpdateSidesCmd())
+
 	return cmd
 }
+
+// startLayouts are the accepted input formats for --start
+var startLayouts = []string{
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+}
+
+// parseStart converts a user-supplied start into the API's local timestamp
+// format. A bare date defaults to noon, matching what the Veo UI sends.
+func parseStart(s string) (string, error) {
+	const apiLayout = "2006-01-02T15:04:05"
+
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return t.Add(12 * time.Hour).Format(apiLayout), nil
+	}
+
+	for _, layout := range startLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.Format(apiLayout), nil
+		}
+	}
+
+	return "", fmt.Errorf("invalid start %q: expected YYYY-MM-DD or YYYY-MM-DD HH:MM", s)
+}
+
+// contains reports whether values contains s
+func contains(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
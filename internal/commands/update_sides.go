@@ -0,0 +1,163 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+
+	"github.com/justincampbell/veo"
+	"github.com/spf13/cobra"
+)
+
+// formationPattern matches formations like 4-4-2 or 4-2-3-1
+var formationPattern = regexp.MustCompile(`^\d+(-\d+)+$`)
+
+// clearValue is the flag value that clears a field to null
+const clearValue = "none"
+
+// sidesFlag describes a flag that edits one team sides field
+type sidesFlag struct {
+	name     string
+	usage    string
+	validate func(string) error
+	field    func(*veo.MatchUpdate) **veo.NullableString
+}
+
+var sidesFlags = []sidesFlag{
+	{
+		name:  "opponent-club",
+		usage: "Opponent club name",
+		field: func(u *veo.MatchUpdate) **veo.NullableString { return &u.OpponentClubName },
+	},
+	{
+		name:  "opponent-team",
+		usage: "Opponent team name",
+		field: func(u *veo.MatchUpdate) **veo.NullableString { return &u.OpponentTeamName },
+	},
+	{
+		name:  "opponent-short",
+		usage: "Opponent short name (e.g. OPP)",
+		field: func(u *veo.MatchUpdate) **veo.NullableString { return &u.OpponentShortName },
+	},
+	{
+		name:  "opponent-color",
+		usage: "Opponent team color (e.g. yellow)",
+		field: func(u *veo.MatchUpdate) **veo.NullableString { return &u.OpponentTeamColor },
+	},
+	{
+		name:  "own-color",
+		usage: "Own team color (e.g. orange)",
+		field: func(u *veo.MatchUpdate) **veo.NullableString { return &u.OwnTeamColor },
+	},
+	{
+		name:     "own-formation",
+		usage:    "Own team formation (e.g. 4-3-1)",
+		validate: validateFormation,
+		field:    func(u *veo.MatchUpdate) **veo.NullableString { return &u.OwnTeamFormation },
+	},
+	{
+		name:     "opponent-formation",
+		usage:    "Opponent team formation (e.g. 4-4-2)",
+		validate: validateFormation,
+		field:    func(u *veo.MatchUpdate) **veo.NullableString { return &u.OpponentTeamFormation },
+	},
+}
+
+// newUpdateSidesCmd creates the update sides subcommand
+func newUpdateSidesCmd() *cobra.Command {
+	var clubSlug string
+	var output *outputFlags
+	values := make([]string, len(sidesFlags))
+
+	cmd := &cobra.Command{
+		Use:   "sides [recording-id|latest]",
+		Short: "Update team sides and colors",
+		Long: `Update opponent details, team colors and formations of a match.
+
+Only the flags that are given are sent to the API. Pass "none" to clear a
+field, e.g. --opponent-formation=none. Colors are passed to Veo as given
+and checked by the API.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			update := &veo.MatchUpdate{}
+
+			for i, f := range sidesFlags {
+				if !cmd.Flags().Changed(f.name) {
+					continue
+				}
+				value, err := parseSidesValue(values[i], f.validate)
+				if err != nil {
+					return fmt.Errorf("invalid --%s: %w", f.name, err)
+				}
+				*f.field(update) = value
+			}
+
+			if *update == (veo.MatchUpdate{}) {
+				return fmt.Errorf("nothing to update: specify at least one field flag")
+			}
+
+			s, err := loadSettings(cmd, clubSlug)
+			if err != nil {
+				return err
+			}
+			format, err := output.resolve(cmd, s)
+			if err != nil {
+				return err
+			}
+			if err := s.requireToken(); err != nil {
+				return err
+			}
+
+			// Create API client
+			client := s.newClient()
+
+			sel, err := resolveRecordingArg(cmd, client, s, args)
+			if err != nil {
+				return err
+			}
+			printTitleMatch(os.Stderr, sel)
+
+			details, err := client.UpdateMatch(cmd.Context(), sel.id, update)
+			if err != nil {
+				return fmt.Errorf("failed to update recording: %w", err)
+			}
+
+			if format == formatTable {
+				printRecordingDetails(details, nil)
+				return nil
+			}
+
+			return output.write(cmd.Context(), cmd.OutOrStdout(), client, format, details, detailsTable(details, nil))
+		},
+	}
+
+	for i, f := range sidesFlags {
+		cmd.Flags().StringVar(&values[i], f.name, "", f.usage)
+	}
+	cmd.Flags().StringVarP(&clubSlug, "club", "c", "", "Club slug (required to select recordings by other than ID, or set VEO_CLUB or a config profile)")
+	output = addOutputFlags(cmd)
+
+	return cmd
+}
+
+// parseSidesValue converts a flag value into a nullable field, treating
+// "none" as an explicit null
+func parseSidesValue(s string, validate func(string) error) (*veo.NullableString, error) {
+	if s == clearValue {
+		return &veo.NullableString{Null: true}, nil
+	}
+	if validate != nil {
+		if err := validate(s); err != nil {
+			return nil, err
+		}
+	}
+	return &veo.NullableString{Value: s}, nil
+}
+
+// validateFormation checks a formation matches the N-N-N pattern
+func validateFormation(s string) error {
+	if !formationPattern.MatchString(s) {
+		return fmt.Errorf("formation %q must look like 4-4-2", s)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package commands
+
+import (
+	"testing"
+)
+
+func TestParseStart(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "date only defaults to noon",
+			input:    "2025-11-16",
+			expected: "2025-11-16T12:00:00",
+		},
+		{
+			name:     "date and time",
+			input:    "2025-11-16 09:30",
+			expected: "2025-11-16T09:30:00",
+		},
+		{
+			name:     "API format",
+			input:    "2025-11-16T15:58:21",
+			expected: "2025-11-16T15:58:21",
+		},
+		{
+			name:    "invalid",
+			input:   "16/11/2025",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := parseStart(tt.input)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("parseStart(%q) expected error, got %q", tt.input, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseStart(%q) failed: %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("parseStart(%q) = %q, expected %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestUpdateCmdRequiresChanges(t *testing.T) {
+	cmd := NewUpdateCmd()
+	cmd.SetArgs([]string{"some-id"})
+	cmd.SilenceUsage = true
+	cmd.SilenceErrors = true
+
+	if err := cmd.Execute(); err == nil {
+		t.Error("expected error when no update flags are given")
+	}
+}
+
+func TestParseSidesValue(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		validate  func(string) error
+		wantNull  bool
+		wantValue string
+		wantErr   bool
+	}{
+		{
+			name:      "plain value",
+			input:     "Opponent FC",
+			wantValue: "Opponent FC",
+		},
+		{
+			name:     "none clears the field",
+			input:    "none",
+			validate: validateFormation,
+			wantNull: true,
+		},
+		{
+			name:      "valid formation",
+			input:     "4-2-3-1",
+			validate:  validateFormation,
+			wantValue: "4-2-3-1",
+		},
+		{
+			name:     "invalid formation",
+			input:    "442",
+			validate: validateFormation,
+			wantErr:  true,
+		},
+		{
+			// Colors are validated by the API
+			name:      "any color",
+			input:     "chartreuse",
+			wantValue: "chartreuse",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := parseSidesValue(tt.input, tt.validate)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("parseSidesValue(%q) expected error", tt.input)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseSidesValue(%q) failed: %v", tt.input, err)
+			}
+			if result.Null != tt.wantNull {
+				t.Errorf("parseSidesValue(%q).Null = %v, expected %v", tt.input, result.Null, tt.wantNull)
+			}
+			if result.Value != tt.wantValue {
+				t.Errorf("parseSidesValue(%q).Value = %q, expected %q", tt.input, result.Value, tt.wantValue)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/justincampbell/veo"
+	"github.com/spf13/cobra"
+)
+
+// NewVideosCmd creates the videos command
+func NewVideosCmd() *cobra.Command {
+	var clubSlug string
+	var output *outputFlags
+
+	cmd := &cobra.Command{
+		Use:   "videos [recording-id|latest]",
+		Short: "List video streams for a recording",
+		Long: `List the video streams of a recording (panorama, follow-cam, reel) with
+their resolution, duration and download URL.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			s, err := loadSettings(cmd, clubSlug)
+			if err != nil {
+				return err
+			}
+			format, err := output.resolve(cmd, s)
+			if err != nil {
+				return err
+			}
+			if err := s.requireToken(); err != nil {
+				return err
+			}
+
+			// Create API client
+			client := s.newClient()
+
+			details, err := resolveRecording(cmd, client, s, args)
+			if err != nil {
+				return err
+			}
+
+			videos, err := client.ListVideos(cmd.Context(), details.Slug)
+			if err != nil {
+				return fmt.Errorf("failed to list videos: %w", err)
+			}
+
+			return output.write(cmd.Context(), cmd.OutOrStdout(), client, format, videos, videosTable(videos))
+		},
+	}
+
+	cmd.Flags().StringVarP(&clubSlug, "club", "c", "", "Club slug (required to select recordings by other than ID, or set VEO_CLUB or a config profile)")
+	output = addOutputFlags(cmd)
+
+	return cmd
+}
+
+// videosTable renders video streams as a table
+func videosTable(videos []veo.Video) *table {
+	t := &table{headers: []string{"ID", "KIND", "RESOLUTION", "DURATION", "URL"}}
+	for _, v := range videos {
+		t.rows = append(t.rows, []string{v.ID, v.Kind, v.Resolution(), formatDuration(v.Duration), v.URL})
+	}
+	return t
+}
@@ -0,0 +1,75 @@
+package commands
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestVideosCmd(t *testing.T) {
+	const recordingID = "7b9e6f0a-1c2d-4e5f-8a9b-0c1d2e3f4a5b"
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/matches/" + recordingID + "/":
+			w.Write([]byte(`{"identifier": "` + recordingID + `", "slug": "20251116-match"}`))
+		case "/matches/20251116-match/videos/":
+			w.Write([]byte(`[
+				{"id": "v1", "kind": "panorama", "url": "https://example.com/pano.mp4", "width": 3840, "height": 1080, "duration": 3410},
+				{"id": "v2", "kind": "reel", "url": "https://example.com/reel.mp4"}
+			]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	t.Setenv("VEO_CONFIG", filepath.Join(t.TempDir(), "config.yaml"))
+	t.Setenv("VEO_BASE_URL", server.URL)
+	t.Setenv("VEO_TOKEN", "test-token")
+	t.Setenv("VEO_PROFILE", "")
+	t.Setenv("VEO_OUTPUT", "")
+
+	var out bytes.Buffer
+	cmd := NewVideosCmd()
+	cmd.SetArgs([]string{recordingID, "-o", "csv"})
+	cmd.SetOut(&out)
+	if err := cmd.Execute(); err != nil {
+		t.Fatalf("videos failed: %v", err)
+	}
+
+	expected := `ID,KIND,RESOLUTION,DURATION,URL
+v1,panorama,3840x1080,00:56:50,https://example.com/pano.mp4
+v2,reel,,00:00:00,https://example.com/reel.mp4
+`
+	if got := out.String(); got != expected {
+		t.Errorf("unexpected output:\n%s\nexpected:\n%s", got, expected)
+	}
+}
+
+func TestVideosCmdNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	t.Setenv("VEO_CONFIG", filepath.Join(t.TempDir(), "config.yaml"))
+	t.Setenv("VEO_BASE_URL", server.URL)
+	t.Setenv("VEO_TOKEN", "test-token")
+	t.Setenv("VEO_PROFILE", "")
+
+	cmd := NewVideosCmd()
+	cmd.SetArgs([]string{"7b9e6f0a-1c2d-4e5f-8a9b-0c1d2e3f4a5b"})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	err := cmd.Execute()
+	if err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("expected a 404 error, got %v", err)
+	}
+	if code := ExitCode(err); code != ExitNotFound {
+		t.Errorf("expected exit code %d, got %d", ExitNotFound, code)
+	}
+}
@@ -0,0 +1,157 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultProfileName is the profile used when none is selected
+const DefaultProfileName = "default"
+
+// Profile holds the settings for one Veo account or club
+type Profile struct {
+	Token     string `yaml:"token,omitempty"`
+	CSRFToken string `yaml:"csrf_token,omitempty"` // csrftoken cookie from login
+	Club      string `yaml:"club,omitempty"`       // Default club slug
+	BaseURL   string `yaml:"base_url,omitempty"`   // API base URL override
+	Timezone  string `yaml:"timezone,omitempty"`   // IANA name, e.g. Europe/Copenhagen
+	Output    string `yaml:"output,omitempty"`     // Default output format
+}
+
+// Config represents the config file with its named profiles
+type Config struct {
+	DefaultProfile string              `yaml:"default_profile,omitempty"`
+	Profiles       map[string]*Profile `yaml:"profiles,omitempty"`
+}
+
+// Path returns the config file location. VEO_CONFIG overrides the default
+// of $XDG_CONFIG_HOME/veo/config.yaml (or ~/.config/veo/config.yaml).
+func Path() (string, error) {
+	if path := os.Getenv("VEO_CONFIG"); path != "" {
+		return path, nil
+	}
+
+	dir := os.Getenv("XDG_CONFIG_HOME")
+	if dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("failed to find home directory: %w", err)
+		}
+		dir = filepath.Join(home, ".config")
+	}
+
+	return filepath.Join(dir, "veo", "config.yaml"), nil
+}
+
+// Load reads the config file at path. A missing file is an empty config.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return &Config{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config %s: %w", path, err)
+	}
+
+	return &cfg, nil
+}
+
+// Save writes the config to path. The file holds credentials, so it is
+// created with 0600 permissions inside a 0700 directory.
+func (c *Config) Save(path string) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("failed to encode config: %w", err)
+	}
+
+	// Write to a temp file and rename so a failed write can't truncate the config
+	tmp, err := os.CreateTemp(dir, ".config-*.yaml")
+	if err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := tmp.Chmod(0600); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+
+	return nil
+}
+
+// EnsureProfile returns the named profile, creating it if needed
+func (c *Config) EnsureProfile(name string) *Profile {
+	if c.Profiles == nil {
+		c.Profiles = make(map[string]*Profile)
+	}
+	if p, ok := c.Profiles[name]; ok && p != nil {
+		return p
+	}
+	p := &Profile{}
+	c.Profiles[name] = p
+	return p
+}
+
+// ProfileName returns the profile to use for name, falling back to the
+// configured default profile and then to "default"
+func (c *Config) ProfileName(name string) string {
+	if name != "" {
+		return name
+	}
+	if c.DefaultProfile != "" {
+		return c.DefaultProfile
+	}
+	return DefaultProfileName
+}
+
+// Profile returns the named profile. Asking for a profile by name that does
+// not exist is an error; the implicit default profile may be missing.
+func (c *Config) Profile(name string) (*Profile, error) {
+	resolved := c.ProfileName(name)
+
+	if p, ok := c.Profiles[resolved]; ok && p != nil {
+		return p, nil
+	}
+
+	if name != "" || c.DefaultProfile != "" {
+		return nil, fmt.Errorf("profile %q not found in config (available: %v)", resolved, c.ProfileNames())
+	}
+
+	return &Profile{}, nil
+}
+
+// ProfileNames returns the names of all profiles, sorted
+func (c *Config) ProfileNames() []string {
+	names := make([]string, 0, len(c.Profiles))
+	for name := range c.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
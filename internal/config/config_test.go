@@ -0,0 +1,114 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadMissingFile(t *testing.T) {
+	cfg, err := Load(filepath.Join(t.TempDir(), "config.yaml"))
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	p, err := cfg.Profile("")
+	if err != nil {
+		t.Fatalf("Profile failed: %v", err)
+	}
+	if p.Token != "" {
+		t.Errorf("expected empty profile, got %+v", p)
+	}
+}
+
+func TestLoadProfiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	data := `default_profile: home
+profiles:
+  home:
+    token: home-token
+    club: home-club
+    timezone: Europe/Copenhagen
+  away:
+    token: away-token
+    club: away-club
+    base_url: http://localhost:8080
+    output: json
+`
+	if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+
+	p, err := cfg.Profile("")
+	if err != nil {
+		t.Fatalf("Profile failed: %v", err)
+	}
+	if p.Club != "home-club" {
+		t.Errorf("expected default profile club 'home-club', got %q", p.Club)
+	}
+
+	p, err = cfg.Profile("away")
+	if err != nil {
+		t.Fatalf("Profile failed: %v", err)
+	}
+	if p.BaseURL != "http://localhost:8080" || p.Output != "json" {
+		t.Errorf("unexpected away profile: %+v", p)
+	}
+
+	if _, err := cfg.Profile("missing"); err == nil {
+		t.Error("expected error for missing profile")
+	}
+}
+
+func TestPath(t *testing.T) {
+	t.Setenv("VEO_CONFIG", "")
+	t.Setenv("XDG_CONFIG_HOME", "/tmp/xdg")
+
+	path, err := Path()
+	if err != nil {
+		t.Fatalf("Path failed: %v", err)
+	}
+	if path != "/tmp/xdg/veo/config.yaml" {
+		t.Errorf("expected XDG path, got %q", path)
+	}
+
+	t.Setenv("VEO_CONFIG", "/tmp/custom.yaml")
+	path, _ = Path()
+	if path != "/tmp/custom.yaml" {
+		t.Errorf("expected VEO_CONFIG path, got %q", path)
+	}
+}
+
+func TestSave(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "veo", "config.yaml")
+
+	cfg := &Config{}
+	p := cfg.EnsureProfile("work")
+	p.Token = "secret-token"
+	p.CSRFToken = "csrf"
+
+	if err := cfg.Save(path); err != nil {
+		t.Fatalf("Save failed: %v", err)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0600 {
+		t.Errorf("expected permissions 0600, got %o", perm)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if got := loaded.Profiles["work"]; got == nil || got.Token != "secret-token" || got.CSRFToken != "csrf" {
+		t.Errorf("unexpected profile after reload: %+v", got)
+	}
+}
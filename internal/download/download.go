@@ -0,0 +1,211 @@
+package download
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// partSuffix is appended to the destination while a download is in progress
+const partSuffix = ".part"
+
+// Downloader downloads files over HTTP, resuming partial downloads
+type Downloader struct {
+	HTTPClient   *http.Client
+	Progress     io.Writer // If set, a progress bar is drawn here
+	Limiter      *Limiter  // If set, caps the transfer rate
+	SkipExisting bool      // Skip files that already exist with the remote size
+}
+
+// Result describes a completed download
+type Result struct {
+	Path    string
+	Size    int64 // Total size of the file
+	Written int64 // Bytes transferred by this download
+	Resumed bool  // True if an earlier partial download was continued
+	Skipped bool  // True if the file already existed complete
+}
+
+// New creates a Downloader with a default HTTP client
+func New() *Downloader {
+	return &Downloader{HTTPClient: &http.Client{}}
+}
+
+// Download fetches url to path. Data is written to path+".part" and renamed
+// once complete, so an interrupted download resumes from where it stopped
+// using an HTTP Range request. The final size is checked against the size
+// reported by the server.
+func (d *Downloader) Download(ctx context.Context, url, path string) (*Result, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	if d.SkipExisting {
+		if size, ok := d.existingComplete(ctx, url, path); ok {
+			return &Result{Path: path, Size: size, Skipped: true}, nil
+		}
+	}
+
+	partPath := path + partSuffix
+	var offset int64
+	if info, err := os.Stat(partPath); err == nil {
+		offset = info.Size()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	if offset > 0 {
+		req.Header.Set("Range", fmt.Sprintf("bytes=%d-", offset))
+	}
+
+	resp, err := d.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("download failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var total int64 = -1
+	flags := os.O_CREATE | os.O_WRONLY
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		// Server ignored the range (or there was none): start over
+		offset = 0
+		flags |= os.O_TRUNC
+		total = resp.ContentLength
+	case http.StatusPartialContent:
+		start, size, err := parseContentRange(resp.Header.Get("Content-Range"))
+		if err != nil {
+			return nil, err
+		}
+		if start != offset {
+			return nil, fmt.Errorf("server resumed at byte %d, expected %d", start, offset)
+		}
+		flags |= os.O_APPEND
+		total = size
+	case http.StatusRequestedRangeNotSatisfiable:
+		// The partial file may already hold everything
+		_, size, err := parseContentRange(resp.Header.Get("Content-Range"))
+		if err == nil && size == offset {
+			return d.finish(partPath, path, &Result{Size: size, Resumed: true})
+		}
+		return nil, fmt.Errorf("server rejected resume at byte %d; remove %s to start over", offset, partPath)
+	default:
+		return nil, fmt.Errorf("download failed with status %d", resp.StatusCode)
+	}
+
+	f, err := os.OpenFile(partPath, flags, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", partPath, err)
+	}
+
+	var body io.Reader = resp.Body
+	if d.Limiter != nil {
+		body = &limitedReader{ctx: ctx, r: body, limiter: d.Limiter}
+	}
+	var bar *progressBar
+	if d.Progress != nil {
+		bar = newProgressBar(d.Progress, filepath.Base(path), offset, total)
+		body = io.TeeReader(body, bar)
+	}
+
+	written, copyErr := io.Copy(f, body)
+	closeErr := f.Close()
+	if bar != nil {
+		bar.done()
+	}
+	if copyErr != nil {
+		return nil, fmt.Errorf("download interrupted after %d bytes (rerun to resume): %w", offset+written, copyErr)
+	}
+	if closeErr != nil {
+		return nil, fmt.Errorf("failed to write %s: %w", partPath, closeErr)
+	}
+
+	size := offset + written
+	if total >= 0 && size != total {
+		return nil, fmt.Errorf("size mismatch: got %d bytes, expected %d (rerun to resume)", size, total)
+	}
+
+	return d.finish(partPath, path, &Result{Size: size, Written: written, Resumed: offset > 0})
+}
+
+// existingComplete reports whether path already exists with the size the
+// server reports for url. If the server doesn't report a size, any existing
+// file counts as complete.
+func (d *Downloader) existingComplete(ctx context.Context, url, path string) (int64, bool) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return 0, false
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "HEAD", url, nil)
+	if err != nil {
+		return 0, false
+	}
+
+	resp, err := d.HTTPClient.Do(req)
+	if err != nil {
+		return 0, false
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, false
+	}
+	if resp.ContentLength >= 0 && resp.ContentLength != info.Size() {
+		return 0, false
+	}
+
+	return info.Size(), true
+}
+
+// finish moves a complete partial download into place
+func (d *Downloader) finish(partPath, path string, result *Result) (*Result, error) {
+	if err := os.Rename(partPath, path); err != nil {
+		return nil, fmt.Errorf("failed to move download into place: %w", err)
+	}
+	result.Path = path
+	return result, nil
+}
+
+// parseContentRange parses a Content-Range header such as "bytes 100-199/200"
+// or "bytes */200", returning the first byte and the total size
+func parseContentRange(header string) (start, size int64, err error) {
+	spec, ok := strings.CutPrefix(header, "bytes ")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid Content-Range %q", header)
+	}
+
+	rng, sizeStr, ok := strings.Cut(spec, "/")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid Content-Range %q", header)
+	}
+
+	size = -1
+	if sizeStr != "*" {
+		if size, err = strconv.ParseInt(sizeStr, 10, 64); err != nil {
+			return 0, 0, fmt.Errorf("invalid Content-Range %q", header)
+		}
+	}
+
+	if rng == "*" {
+		return 0, size, nil
+	}
+
+	startStr, _, ok := strings.Cut(rng, "-")
+	if !ok {
+		return 0, 0, fmt.Errorf("invalid Content-Range %q", header)
+	}
+	if start, err = strconv.ParseInt(startStr, 10, 64); err != nil {
+		return 0, 0, fmt.Errorf("invalid Content-Range %q", header)
+	}
+
+	return start, size, nil
+}
@@ -0,0 +1,275 @@
+package download
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testContent = []byte(strings.Repeat("0123456789", 100))
+
+// newFileServer serves testContent with Range support
+func newFileServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.ServeContent(w, r, "video.mp4", time.Time{}, bytes.NewReader(testContent))
+	}))
+}
+
+func TestDownload(t *testing.T) {
+	server := newFileServer(t)
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "nested", "video.mp4")
+	result, err := New().Download(context.Background(), server.URL, path)
+	if err != nil {
+		t.Fatalf("Download failed: %v", err)
+	}
+
+	if result.Size != int64(len(testContent)) || result.Resumed {
+		t.Errorf("unexpected result: %+v", result)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, testContent) {
+		t.Error("downloaded content does not match")
+	}
+
+	if _, err := os.Stat(path + partSuffix); !os.IsNotExist(err) {
+		t.Error("expected partial file to be removed")
+	}
+}
+
+func TestDownloadResume(t *testing.T) {
+	var rangeHeader string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rangeHeader = r.Header.Get("Range")
+		http.ServeContent(w, r, "video.mp4", time.Time{}, bytes.NewReader(testContent))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "video.mp4")
+	if err := os.WriteFile(path+partSuffix, testContent[:400], 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := New().Download(context.Background(), server.URL, path)
+	if err != nil {
+		t.Fatalf("Download failed: %v", err)
+	}
+
+	if rangeHeader != "bytes=400-" {
+		t.Errorf("expected Range 'bytes=400-', got %q", rangeHeader)
+	}
+	if !result.Resumed || result.Written != int64(len(testContent)-400) {
+		t.Errorf("unexpected result: %+v", result)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, testContent) {
+		t.Error("resumed content does not match")
+	}
+}
+
+func TestDownloadRestartsWhenRangeIgnored(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(testContent)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "video.mp4")
+	if err := os.WriteFile(path+partSuffix, []byte("stale data"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := New().Download(context.Background(), server.URL, path); err != nil {
+		t.Fatalf("Download failed: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data, testContent) {
+		t.Error("expected download to start over")
+	}
+}
+
+func TestDownloadSizeMismatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Promise more bytes than are sent
+		w.Header().Set("Content-Length", fmt.Sprint(len(testContent)+10))
+		w.Write(testContent)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "video.mp4")
+	if _, err := New().Download(context.Background(), server.URL, path); err == nil {
+		t.Fatal("expected error for truncated download")
+	}
+
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Error("expected incomplete download not to be moved into place")
+	}
+	if _, err := os.Stat(path + partSuffix); err != nil {
+		t.Error("expected partial file to be kept for resume")
+	}
+}
+
+func TestParseContentRange(t *testing.T) {
+	tests := []struct {
+		header    string
+		wantStart int64
+		wantSize  int64
+		wantErr   bool
+	}{
+		{header: "bytes 100-199/200", wantStart: 100, wantSize: 200},
+		{header: "bytes */200", wantStart: 0, wantSize: 200},
+		{header: "bytes 0-99/*", wantStart: 0, wantSize: -1},
+		{header: "items 0-1/2", wantErr: true},
+		{header: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.header, func(t *testing.T) {
+			start, size, err := parseContentRange(tt.header)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("parseContentRange(%q) expected error", tt.header)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseContentRange(%q) failed: %v", tt.header, err)
+			}
+			if start != tt.wantStart || size != tt.wantSize {
+				t.Errorf("parseContentRange(%q) = %d, %d, expected %d, %d", tt.header, start, size, tt.wantStart, tt.wantSize)
+			}
+		})
+	}
+}
+
+func TestDownloadAlreadyCompletePart(t *testing.T) {
+	server := newFileServer(t)
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "video.mp4")
+	if err := os.WriteFile(path+partSuffix, testContent, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := New().Download(context.Background(), server.URL, path)
+	if err != nil {
+		t.Fatalf("Download failed: %v", err)
+	}
+	if result.Written != 0 || result.Size != int64(len(testContent)) {
+		t.Errorf("unexpected result: %+v", result)
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Error("expected complete partial file to be moved into place")
+	}
+}
+
+func TestDownloadSkipExisting(t *testing.T) {
+	var gets int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" {
+			gets++
+		}
+		http.ServeContent(w, r, "video.mp4", time.Time{}, bytes.NewReader(testContent))
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	complete := filepath.Join(dir, "complete.mp4")
+	if err := os.WriteFile(complete, testContent, 0644); err != nil {
+		t.Fatal(err)
+	}
+	truncated := filepath.Join(dir, "truncated.mp4")
+	if err := os.WriteFile(truncated, testContent[:10], 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	d := New()
+	d.SkipExisting = true
+
+	result, err := d.Download(context.Background(), server.URL, complete)
+	if err != nil {
+		t.Fatalf("Download failed: %v", err)
+	}
+	if !result.Skipped || gets != 0 {
+		t.Errorf("expected complete file to be skipped, got %+v after %d GETs", result, gets)
+	}
+
+	result, err = d.Download(context.Background(), server.URL, truncated)
+	if err != nil {
+		t.Fatalf("Download failed: %v", err)
+	}
+	if result.Skipped || gets != 1 {
+		t.Errorf("expected truncated file to be downloaded again, got %+v after %d GETs", result, gets)
+	}
+}
+
+func TestDownloadWithLimiter(t *testing.T) {
+	server := newFileServer(t)
+	defer server.Close()
+
+	d := New()
+	d.Limiter = NewLimiter(2000)
+	// Use up the initial burst so the transfer has to wait
+	d.Limiter.wait(context.Background(), 2000)
+
+	start := time.Now()
+	if _, err := d.Download(context.Background(), server.URL, filepath.Join(t.TempDir(), "video.mp4")); err != nil {
+		t.Fatalf("Download failed: %v", err)
+	}
+
+	// 1000 bytes at 2000 bytes/s takes about half a second
+	if elapsed := time.Since(start); elapsed < 400*time.Millisecond {
+		t.Errorf("expected download to be throttled, took %v", elapsed)
+	}
+}
+
+func TestLimiterWaitCancelled(t *testing.T) {
+	l := NewLimiter(1000)
+	// Empty the bucket, so waiting for 10000 bytes takes ten seconds
+	l.wait(context.Background(), 1000)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if err := l.wait(ctx, 10000); err != context.DeadlineExceeded {
+		t.Errorf("expected DeadlineExceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected wait to stop when cancelled, took %v", elapsed)
+	}
+
+	// The cancelled bytes are given back, so waiters aren't held up by them
+	if err := l.wait(context.Background(), 0); err != nil {
+		t.Fatal(err)
+	}
+	if l.tokens < -10 {
+		t.Errorf("expected cancelled bytes to be returned, tokens = %v", l.tokens)
+	}
+}
+
+func TestNewLimiterMinimumRate(t *testing.T) {
+	l := NewLimiter(0)
+	if l.burst() != 1 {
+		t.Errorf("expected a rate of at least 1 byte/s, got burst %d", l.burst())
+	}
+}
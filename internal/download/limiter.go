@@ -0,0 +1,86 @@
+package download
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+)
+
+// Limiter caps the combined transfer rate of any number of downloads. It is
+// a token bucket holding up to one second of bytes, shared across goroutines.
+type Limiter struct {
+	mu     sync.Mutex
+	rate   float64 // bytes per second
+	tokens float64
+	last   time.Time
+}
+
+// NewLimiter creates a Limiter allowing bytesPerSecond in total. Rates
+// below 1 byte per second are raised to 1.
+func NewLimiter(bytesPerSecond int64) *Limiter {
+	bytesPerSecond = max(bytesPerSecond, 1)
+	return &Limiter{
+		rate:   float64(bytesPerSecond),
+		tokens: float64(bytesPerSecond),
+		last:   time.Now(),
+	}
+}
+
+// burst is the most bytes that can be taken at once
+func (l *Limiter) burst() int {
+	return int(l.rate)
+}
+
+// wait blocks until n bytes may be transferred, or ctx is done
+func (l *Limiter) wait(ctx context.Context, n int) error {
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens = min(l.tokens+now.Sub(l.last).Seconds()*l.rate, l.rate)
+	l.last = now
+
+	// Take the bytes now, going below zero if needed, so later callers wait
+	// behind this one without the lock being held while sleeping
+	l.tokens -= float64(n)
+	delay := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// Give back the bytes that won't be transferred
+		l.mu.Lock()
+		l.tokens += float64(n)
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// limitedReader throttles reads through a Limiter
+type limitedReader struct {
+	ctx     context.Context
+	r       io.Reader
+	limiter *Limiter
+}
+
+// Read reads at most one burst and waits for the bytes read
+func (lr *limitedReader) Read(p []byte) (int, error) {
+	if burst := lr.limiter.burst(); len(p) > burst && burst > 0 {
+		p = p[:burst]
+	}
+	n, err := lr.r.Read(p)
+	if n > 0 {
+		if waitErr := lr.limiter.wait(lr.ctx, n); waitErr != nil {
+			return n, waitErr
+		}
+	}
+	return n, err
+}
@@ -0,0 +1,88 @@
+package download
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// progressBarWidth is the number of characters in the bar itself
+const progressBarWidth = 30
+
+// progressBar draws a single-line progress bar for a download
+type progressBar struct {
+	w       io.Writer
+	name    string
+	current int64
+	total   int64 // -1 if unknown
+	resumed int64 // Bytes already present when the download started
+	started time.Time
+	drawn   time.Time
+}
+
+// newProgressBar creates a progress bar starting at offset bytes
+func newProgressBar(w io.Writer, name string, offset, total int64) *progressBar {
+	return &progressBar{
+		w:       w,
+		name:    name,
+		current: offset,
+		total:   total,
+		resumed: offset,
+		started: time.Now(),
+	}
+}
+
+// Write records progress; it is used with io.TeeReader
+func (p *progressBar) Write(b []byte) (int, error) {
+	p.current += int64(len(b))
+	if time.Since(p.drawn) >= 200*time.Millisecond {
+		p.draw()
+	}
+	return len(b), nil
+}
+
+// done draws the final state and ends the line
+func (p *progressBar) done() {
+	p.draw()
+	fmt.Fprintln(p.w)
+}
+
+// draw renders the bar
+func (p *progressBar) draw() {
+	p.drawn = time.Now()
+
+	var rate float64
+	if elapsed := time.Since(p.started).Seconds(); elapsed > 0 {
+		rate = float64(p.current-p.resumed) / elapsed
+	}
+
+	if p.total <= 0 {
+		fmt.Fprintf(p.w, "\r%s  %s  %s/s", p.name, formatBytes(p.current), formatBytes(int64(rate)))
+		return
+	}
+
+	fraction := float64(p.current) / float64(p.total)
+	if fraction > 1 {
+		fraction = 1
+	}
+	filled := int(fraction * progressBarWidth)
+	bar := strings.Repeat("=", filled) + strings.Repeat(" ", progressBarWidth-filled)
+
+	fmt.Fprintf(p.w, "\r%s [%s] %3.0f%%  %s/%s  %s/s",
+		p.name, bar, fraction*100, formatBytes(p.current), formatBytes(p.total), formatBytes(int64(rate)))
+}
+
+// formatBytes formats a byte count with a binary unit
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,151 @@
+package veo
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Recording represents a recording from the list endpoint
+type Recording struct {
+	Camera       string    `json:"camera"`
+	Created      time.Time `json:"created"`  // Upload/processing time
+	Start        time.Time `json:"start"`    // Actual recording start time (match date)
+	Duration     int       `json:"duration"` // in seconds
+	Identifier   string    `json:"identifier"`
+	Slug         string    `json:"slug"`
+	Title        string    `json:"title"`
+	Type         string    `json:"type"` // match, tournament, training or scrimmage
+	URL          string    `json:"url"`
+	Thumbnail    string    `json:"thumbnail"`
+	ReelURL      string    `json:"reel_url"` // Full game highlights/reel download URL
+	Team         string    `json:"team"`
+	Privacy      string    `json:"privacy"`
+	Permissions  string    `json:"permissions"`
+	IsAccessible bool      `json:"is_accessible"`
+
+	// Extra holds returned fields that have no field above, e.g. ones
+	// requested with ListRecordingsOptions.Fields. They are written back out
+	// when the recording is encoded as JSON.
+	Extra map[string]json.RawMessage `json:"-"`
+}
+
+// recordingJSON has Recording's fields without its JSON methods
+type recordingJSON Recording
+
+// recordingKeys are the JSON keys of Recording's named fields
+var recordingKeys = jsonKeys(reflect.TypeFor[Recording]())
+
+// UnmarshalJSON decodes a recording, keeping unknown fields in Extra
+func (r *Recording) UnmarshalJSON(data []byte) error {
+	if err := json.Unmarshal(data, (*recordingJSON)(r)); err != nil {
+		return err
+	}
+
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return err
+	}
+	for key := range recordingKeys {
+		delete(all, key)
+	}
+
+	r.Extra = nil
+	if len(all) > 0 {
+		r.Extra = all
+	}
+	return nil
+}
+
+// MarshalJSON encodes a recording, followed by its Extra fields in key order
+func (r Recording) MarshalJSON() ([]byte, error) {
+	data, err := json.Marshal(recordingJSON(r))
+	if err != nil || len(r.Extra) == 0 {
+		return data, err
+	}
+
+	var buf bytes.Buffer
+	buf.Write(data[:len(data)-1]) // Without the closing brace
+	for _, key := range slices.Sorted(maps.Keys(r.Extra)) {
+		if _, ok := recordingKeys[key]; ok {
+			continue
+		}
+		name, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		buf.WriteByte(',')
+		buf.Write(name)
+		buf.WriteByte(':')
+		if err := json.Compact(&buf, r.Extra[key]); err != nil {
+			return nil, fmt.Errorf("invalid JSON in extra field %q: %w", key, err)
+		}
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// jsonKeys returns the JSON keys of a struct type's exported fields
+func jsonKeys(t reflect.Type) map[string]struct{} {
+	keys := make(map[string]struct{})
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = field.Name
+		}
+		keys[name] = struct{}{}
+	}
+	return keys
+}
+
+// Highlight represents a highlight clip from a match
+type Highlight struct {
+	ID              string           `json:"id"`
+	Created         time.Time        `json:"created"`
+	Start           float64          `json:"start"`    // Offset into the match, in seconds
+	Duration        float64          `json:"duration"` // in seconds
+	Thumbnail       string           `json:"thumbnail"`
+	IsAIGenerated   bool             `json:"is_ai_generated"`
+	AIResolution    string           `json:"ai_resolution"`
+	Videos          []HighlightVideo `json:"videos"`
+	Tags            []string         `json:"tags"`
+	InvolvedPlayers []interface{}    `json:"involved_players"` // Player objects or IDs
+}
+
+// HighlightVideo is a rendered video file for a highlight
+type HighlightVideo struct {
+	URL    string `json:"url"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+}
+
+// Video represents a video stream of a match. The fields are assumed from
+// the other endpoints and have not been confirmed against a captured response.
+type Video struct {
+	ID       string `json:"id"`
+	Kind     string `json:"kind"` // e.g. panorama, follow-cam, reel
+	URL      string `json:"url"`
+	Width    int    `json:"width"`
+	Height   int    `json:"height"`
+	Duration int    `json:"duration"` // in seconds
+}
+
+// Resolution returns the video resolution as WIDTHxHEIGHT, or "" if unknown
+func (v Video) Resolution() string {
+	if v.Width == 0 || v.Height == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%dx%d", v.Width, v.Height)
+}
@@ -0,0 +1,54 @@
+package veo
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRecordingExtraFields(t *testing.T) {
+	data := []byte(`{"identifier": "id1", "title": "Match", "processing_status": "done", "device": {"serial": "vc3-1"}}`)
+
+	var r Recording
+	if err := json.Unmarshal(data, &r); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	if r.Identifier != "id1" || r.Title != "Match" {
+		t.Errorf("known fields not decoded: %+v", r)
+	}
+	if len(r.Extra) != 2 {
+		t.Fatalf("expected 2 extra fields, got %v", r.Extra)
+	}
+	if string(r.Extra["processing_status"]) != `"done"` {
+		t.Errorf("unexpected processing_status: %s", r.Extra["processing_status"])
+	}
+
+	out, err := json.Marshal(r)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+
+	var decoded map[string]any
+	if err := json.Unmarshal(out, &decoded); err != nil {
+		t.Fatalf("output is not valid JSON: %v\n%s", err, out)
+	}
+	if decoded["processing_status"] != "done" {
+		t.Errorf("expected processing_status in output, got %s", out)
+	}
+	if device, ok := decoded["device"].(map[string]any); !ok || device["serial"] != "vc3-1" {
+		t.Errorf("expected device in output, got %s", out)
+	}
+	if decoded["title"] != "Match" {
+		t.Errorf("expected title in output, got %s", out)
+	}
+}
+
+func TestRecordingWithoutExtraFields(t *testing.T) {
+	var r Recording
+	if err := json.Unmarshal([]byte(`{"identifier": "id1"}`), &r); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if r.Extra != nil {
+		t.Errorf("expected no extra fields, got %v", r.Extra)
+	}
+}
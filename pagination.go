@@ -0,0 +1,406 @@
+package veo
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultRecordingFields are the fields requested when listing recordings
+// if ListRecordingsOptions.Fields is empty. They cover every named field of
+// Recording.
+var DefaultRecordingFields = []string{
+	"camera",
+	"created",
+	"start",
+	"duration",
+	"identifier",
+	"slug",
+	"title",
+	"type",
+	"url",
+	"thumbnail",
+	"reel_url",
+	"team",
+	"privacy",
+	"permissions",
+	"is_accessible",
+}
+
+// FilterOwn is the recordings endpoint filter for the club's own recordings,
+// and the default for ListRecordingsOptions.Filter. It is the only filter
+// value known to be accepted.
+const FilterOwn = "own"
+
+// recordingsPage is a single page of a club's recordings
+type recordingsPage struct {
+	recordings []Recording
+	totalCount int    // From the x-veo-total-count header, 0 if missing
+	next       string // URL of the next page, "" on the last page
+}
+
+// Recordings returns an iterator over a club's recordings, starting at
+// opts.Page and following the API's next links. Pages are fetched as the loop
+// advances, so breaking out of it early skips the remaining pages. If a
+// request fails, the error is yielded once and iteration stops. opts.FetchAll
+// is ignored.
+func (c *Client) Recordings(ctx context.Context, clubSlug string, opts *ListRecordingsOptions) iter.Seq2[Recording, error] {
+	if opts == nil {
+		opts = &ListRecordingsOptions{}
+	}
+
+	return func(yield func(Recording, error) bool) {
+		for page, err := range c.recordingPages(ctx, clubSlug, opts) {
+			if err != nil {
+				yield(Recording{}, err)
+				return
+			}
+			for _, r := range page.recordings {
+				if !yield(r, nil) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// recordingPages returns an iterator over pages of a club's recordings,
+// starting at opts.Page
+func (c *Client) recordingPages(ctx context.Context, clubSlug string, opts *ListRecordingsOptions) iter.Seq2[*recordingsPage, error] {
+	return c.pagesFrom(ctx, recordingsPath(clubSlug, opts))
+}
+
+// pagesFrom returns an iterator over pages of recordings, starting at target
+// and following next links
+func (c *Client) pagesFrom(ctx context.Context, target string) iter.Seq2[*recordingsPage, error] {
+	return func(yield func(*recordingsPage, error) bool) {
+		for target != "" {
+			p, err := c.fetchRecordingsPage(ctx, target)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if !yield(p, nil) {
+				return
+			}
+
+			// Guard against a server linking a page to itself
+			if p.next == c.resolveURL(target) {
+				return
+			}
+			target = p.next
+		}
+	}
+}
+
+// listRecordingsConcurrently fetches the first page, works out the number of
+// pages from the total count, then fetches the rest with up to
+// opts.Concurrency requests in flight. Remaining pages are addressed by
+// setting the page parameter of the first page's next link. If the count or
+// page numbers aren't available, it falls back to following next links.
+func (c *Client) listRecordingsConcurrently(ctx context.Context, clubSlug string, opts *ListRecordingsOptions) (*ListRecordingsResult, error) {
+	startPage := max(opts.Page, 1)
+
+	first, err := c.fetchRecordingsPage(ctx, recordingsPath(clubSlug, opts))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ListRecordingsResult{
+		Recordings: first.recordings,
+		TotalCount: first.totalCount,
+	}
+
+	next := first.next
+	if targets := pageTargets(first, startPage); len(targets) > 0 {
+		pages, err := c.fetchPages(ctx, targets, opts.Concurrency)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range pages {
+			result.Recordings = append(result.Recordings, p.recordings...)
+		}
+		next = pages[len(pages)-1].next
+	}
+
+	// Follow any remaining links, e.g. if recordings were added while
+	// fetching or the page count couldn't be worked out
+	for p, err := range c.pagesFrom(ctx, next) {
+		if err != nil {
+			return nil, err
+		}
+		result.Recordings = append(result.Recordings, p.recordings...)
+	}
+
+	return result, nil
+}
+
+// pageTargets returns the URLs of the pages after first, up to the last page
+// according to the total count, or nil if they can't be worked out
+func pageTargets(first *recordingsPage, startPage int) []string {
+	perPage := len(first.recordings)
+	if first.next == "" || first.totalCount == 0 || perPage == 0 {
+		return nil
+	}
+
+	u, err := url.Parse(first.next)
+	if err != nil {
+		return nil
+	}
+	query := u.Query()
+	if query.Get("page") != strconv.Itoa(startPage+1) {
+		return nil
+	}
+
+	lastPage := (first.totalCount + perPage - 1) / perPage
+	var targets []string
+	for page := startPage + 1; page <= lastPage; page++ {
+		query.Set("page", strconv.Itoa(page))
+		u.RawQuery = query.Encode()
+		targets = append(targets, u.String())
+	}
+	return targets
+}
+
+// fetchPages fetches the pages at targets with up to workers requests in
+// flight, returning them in order. The first error cancels the rest.
+func (c *Client) fetchPages(ctx context.Context, targets []string, workers int) ([]*recordingsPage, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	pages := make([]*recordingsPage, len(targets))
+
+	var mu sync.Mutex
+	var firstErr error
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for i := 0; i < min(workers, len(targets)); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				p, err := c.fetchRecordingsPage(ctx, targets[idx])
+				if err != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = err
+						cancel()
+					}
+					mu.Unlock()
+					continue
+				}
+				pages[idx] = p
+			}
+		}()
+	}
+
+dispatch:
+	for i := range targets {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return pages, nil
+}
+
+// recordingsPath returns the path of the first page of a club's recordings
+// to fetch for opts
+func recordingsPath(clubSlug string, opts *ListRecordingsOptions) string {
+	fields := opts.Fields
+	if len(fields) == 0 {
+		fields = DefaultRecordingFields
+	}
+
+	filter := opts.Filter
+	if filter == "" {
+		filter = FilterOwn
+	}
+
+	params := url.Values{}
+	params.Set("filter", filter)
+	for _, field := range fields {
+		params.Add("fields", field)
+	}
+	if opts.Page > 1 {
+		params.Set("page", fmt.Sprintf("%d", opts.Page))
+	}
+
+	return fmt.Sprintf("/clubs/%s/recordings/?%s", clubSlug, params.Encode())
+}
+
+// fetchRecordingsPage fetches the page of recordings at target, which is
+// either a path or an absolute next link
+func (c *Client) fetchRecordingsPage(ctx context.Context, target string) (*recordingsPage, error) {
+	resp, err := c.doRequest(ctx, "GET", target, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &recordingsPage{}
+	if countStr := resp.Header.Get("x-veo-total-count"); countStr != "" {
+		fmt.Sscanf(countStr, "%d", &p.totalCount)
+	}
+
+	if err := decodeResponse(resp, &p.recordings); err != nil {
+		return nil, err
+	}
+
+	p.next, err = c.nextPageURL(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// nextPageURL returns the absolute URL of the response's next link, or "" if
+// there is none. Relative links are resolved against the request URL. Links
+// to other hosts are refused so the auth token is never sent elsewhere.
+func (c *Client) nextPageURL(resp *http.Response) (string, error) {
+	next, ok := parseLinkHeader(resp.Header.Get("Link"))["next"]
+	if !ok {
+		return "", nil
+	}
+
+	u, err := resp.Request.URL.Parse(next)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse next page link %q: %w", next, err)
+	}
+
+	base, err := url.Parse(c.baseURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse base URL: %w", err)
+	}
+	if u.Host != base.Host {
+		return "", fmt.Errorf("refusing to follow next page link to another host: %s", u.Host)
+	}
+
+	return u.String(), nil
+}
+
+// parseLinkHeader parses an RFC 8288 (formerly RFC 5988) Link header into a
+// map of relation type to target URL, e.g.
+//
+//	<https://example.com/?page=2>; rel="next", <https://example.com/?page=9>; rel=last
+//
+// A link with several space-separated relation types is stored under each.
+// If a relation appears more than once, the first link wins. Malformed links
+// are skipped.
+func parseLinkHeader(header string) map[string]string {
+	links := make(map[string]string)
+
+	rest := header
+	for {
+		rest = strings.TrimLeft(rest, " \t,")
+		if rest == "" {
+			return links
+		}
+
+		if rest[0] != '<' {
+			rest = skipLink(rest)
+			continue
+		}
+		end := strings.IndexByte(rest, '>')
+		if end < 0 {
+			return links
+		}
+		target := strings.TrimSpace(rest[1:end])
+		rest = rest[end+1:]
+
+		// Parameters follow as ; name=value, up to the next link
+		var rels []string
+		for {
+			rest = strings.TrimLeft(rest, " \t")
+			if rest == "" || rest[0] != ';' {
+				break
+			}
+			var name, value string
+			name, value, rest = parseLinkParam(rest[1:])
+			if strings.EqualFold(name, "rel") && rels == nil {
+				rels = strings.Fields(strings.ToLower(value))
+			}
+		}
+
+		for _, rel := range rels {
+			if _, ok := links[rel]; !ok {
+				links[rel] = target
+			}
+		}
+
+		rest = skipLink(rest)
+	}
+}
+
+// parseLinkParam parses a single name=value link parameter, where the value
+// may be a quoted string, and returns the remaining input
+func parseLinkParam(s string) (name, value, rest string) {
+	s = strings.TrimLeft(s, " \t")
+	end := strings.IndexAny(s, "=;,")
+	if end < 0 {
+		return strings.TrimSpace(s), "", ""
+	}
+	name = strings.TrimSpace(s[:end])
+	if s[end] != '=' {
+		return name, "", s[end:]
+	}
+
+	s = strings.TrimLeft(s[end+1:], " \t")
+	if s == "" || s[0] != '"' {
+		end := strings.IndexAny(s, ";,")
+		if end < 0 {
+			return name, strings.TrimSpace(s), ""
+		}
+		return name, strings.TrimSpace(s[:end]), s[end:]
+	}
+
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch s[i] {
+		case '\\':
+			if i+1 < len(s) {
+				i++
+				b.WriteByte(s[i])
+			}
+		case '"':
+			return name, b.String(), s[i+1:]
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+	return name, b.String(), ""
+}
+
+// skipLink skips to the comma separating the next link, ignoring commas
+// inside quoted strings
+func skipLink(s string) string {
+	quoted := false
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\' && quoted:
+			i++
+		case s[i] == '"':
+			quoted = !quoted
+		case s[i] == ',' && !quoted:
+			return s[i+1:]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,295 @@
+package veo
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+func TestParseLinkHeader(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		expected map[string]string
+	}{
+		{
+			name:     "empty header",
+			header:   "",
+			expected: map[string]string{},
+		},
+		{
+			name:   "next and prev",
+			header: `<https://example.com/?page=3>; rel="next", <https://example.com/?page=1>; rel="prev"`,
+			expected: map[string]string{
+				"next": "https://example.com/?page=3",
+				"prev": "https://example.com/?page=1",
+			},
+		},
+		{
+			name:   "unquoted rel and extra params",
+			header: `<https://example.com/?page=2>; title="Page, two"; rel=next`,
+			expected: map[string]string{
+				"next": "https://example.com/?page=2",
+			},
+		},
+		{
+			name:   "multiple relation types",
+			header: `<https://example.com/?page=9>; rel="last Next"`,
+			expected: map[string]string{
+				"last": "https://example.com/?page=9",
+				"next": "https://example.com/?page=9",
+			},
+		},
+		{
+			name:   "url containing rel text",
+			header: `<https://example.com/?q=rel="next">; rel="prev"`,
+			expected: map[string]string{
+				"prev": `https://example.com/?q=rel="next"`,
+			},
+		},
+		{
+			name:   "malformed link skipped",
+			header: `garbage; rel="next", </clubs/x/recordings/?page=2>; rel="next"`,
+			expected: map[string]string{
+				"next": "/clubs/x/recordings/?page=2",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := parseLinkHeader(tt.header)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("parseLinkHeader(%q) = %v, expected %v", tt.header, result, tt.expected)
+			}
+		})
+	}
+}
+
+// newPagedServer serves pages recordings per page across total recordings,
+// linking each page to the next with a relative Link header
+func newPagedServer(total, perPage int, requests *atomic.Int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		page := 1
+		if p := r.URL.Query().Get("page"); p != "" {
+			page, _ = strconv.Atoi(p)
+		}
+
+		first := (page - 1) * perPage
+		last := min(first+perPage, total)
+		if first+perPage < total {
+			w.Header().Set("Link", fmt.Sprintf(`</clubs/test-club/recordings/?page=%d>; rel="next"`, page+1))
+		}
+		w.Header().Set("x-veo-total-count", strconv.Itoa(total))
+
+		fmt.Fprint(w, "[")
+		for i := first; i < last; i++ {
+			if i > first {
+				fmt.Fprint(w, ",")
+			}
+			fmt.Fprintf(w, `{"identifier": "id%d", "title": "Recording %d"}`, i+1, i+1)
+		}
+		fmt.Fprint(w, "]")
+	}))
+}
+
+func TestRecordingsIterator(t *testing.T) {
+	var requests atomic.Int32
+	server := newPagedServer(5, 2, &requests)
+	defer server.Close()
+
+	c := NewClient(WithBaseURL(server.URL), WithAuthToken("test-token"))
+
+	var ids []string
+	for r, err := range c.Recordings(context.Background(), "test-club", nil) {
+		if err != nil {
+			t.Fatalf("Recordings failed: %v", err)
+		}
+		ids = append(ids, r.Identifier)
+	}
+
+	expected := []string{"id1", "id2", "id3", "id4", "id5"}
+	if !reflect.DeepEqual(ids, expected) {
+		t.Errorf("expected %v, got %v", expected, ids)
+	}
+	if n := requests.Load(); n != 3 {
+		t.Errorf("expected 3 requests, got %d", n)
+	}
+}
+
+func TestRecordingsIteratorStopsEarly(t *testing.T) {
+	var requests atomic.Int32
+	server := newPagedServer(100, 10, &requests)
+	defer server.Close()
+
+	c := NewClient(WithBaseURL(server.URL), WithAuthToken("test-token"))
+
+	count := 0
+	for _, err := range c.Recordings(context.Background(), "test-club", nil) {
+		if err != nil {
+			t.Fatalf("Recordings failed: %v", err)
+		}
+		count++
+		if count == 15 {
+			break
+		}
+	}
+
+	if n := requests.Load(); n != 2 {
+		t.Errorf("expected 2 requests after stopping early, got %d", n)
+	}
+}
+
+func TestRecordingsIteratorRefusesOtherHosts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Link", `<https://evil.example.com/?page=2>; rel="next"`)
+		fmt.Fprint(w, `[{"identifier": "id1"}]`)
+	}))
+	defer server.Close()
+
+	c := NewClient(WithBaseURL(server.URL), WithAuthToken("test-token"))
+
+	var lastErr error
+	for _, err := range c.Recordings(context.Background(), "test-club", nil) {
+		lastErr = err
+	}
+	if lastErr == nil {
+		t.Fatal("expected an error for a next link to another host")
+	}
+}
+
+func TestListRecordingsConcurrently(t *testing.T) {
+	var requests atomic.Int32
+	server := newPagedServer(95, 10, &requests)
+	defer server.Close()
+
+	c := NewClient(WithBaseURL(server.URL), WithAuthToken("test-token"))
+	result, err := c.ListRecordings(context.Background(), "test-club", &ListRecordingsOptions{FetchAll: true, Concurrency: 4})
+	if err != nil {
+		t.Fatalf("ListRecordings failed: %v", err)
+	}
+
+	if len(result.Recordings) != 95 {
+		t.Fatalf("expected 95 recordings, got %d", len(result.Recordings))
+	}
+	for i, r := range result.Recordings {
+		if expected := fmt.Sprintf("id%d", i+1); r.Identifier != expected {
+			t.Fatalf("recording %d: expected %s, got %s", i, expected, r.Identifier)
+		}
+	}
+	if result.TotalCount != 95 {
+		t.Errorf("expected total count 95, got %d", result.TotalCount)
+	}
+	if n := requests.Load(); n != 10 {
+		t.Errorf("expected 10 requests, got %d", n)
+	}
+}
+
+func TestListRecordingsConcurrentlyWithoutCount(t *testing.T) {
+	var requests atomic.Int32
+	paged := newPagedServer(25, 10, &requests)
+	defer paged.Close()
+
+	// Strip the total count so pages can only be found by following links
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp, err := http.Get(paged.URL + r.URL.RequestURI())
+		if err != nil {
+			t.Errorf("proxy request failed: %v", err)
+			return
+		}
+		defer resp.Body.Close()
+		w.Header().Set("Link", resp.Header.Get("Link"))
+		io.Copy(w, resp.Body)
+	}))
+	defer server.Close()
+
+	c := NewClient(WithBaseURL(server.URL), WithAuthToken("test-token"))
+	result, err := c.ListRecordings(context.Background(), "test-club", &ListRecordingsOptions{FetchAll: true, Concurrency: 4})
+	if err != nil {
+		t.Fatalf("ListRecordings failed: %v", err)
+	}
+
+	if len(result.Recordings) != 25 {
+		t.Errorf("expected 25 recordings, got %d", len(result.Recordings))
+	}
+}
+
+func TestListRecordingsConcurrentlyError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") == "3" {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"detail": "Forbidden"}`)
+			return
+		}
+		w.Header().Set("Link", `</clubs/test-club/recordings/?page=2>; rel="next"`)
+		w.Header().Set("x-veo-total-count", "50")
+		fmt.Fprint(w, `[{"identifier": "a"}, {"identifier": "b"}]`)
+	}))
+	defer server.Close()
+
+	c := NewClient(WithBaseURL(server.URL), WithAuthToken("test-token"))
+	_, err := c.ListRecordings(context.Background(), "test-club", &ListRecordingsOptions{FetchAll: true, Concurrency: 4})
+	if !IsForbidden(err) {
+		t.Errorf("expected forbidden error, got %v", err)
+	}
+}
+
+func TestListRecordingsFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fields := r.URL.Query()["fields"]
+		expected := []string{"identifier", "processing_status"}
+		if !reflect.DeepEqual(fields, expected) {
+			t.Errorf("expected fields %v, got %v", expected, fields)
+		}
+		fmt.Fprint(w, `[{"identifier": "id1", "processing_status": "processing"}]`)
+	}))
+	defer server.Close()
+
+	c := NewClient(WithBaseURL(server.URL), WithAuthToken("test-token"))
+	result, err := c.ListRecordings(context.Background(), "test-club", &ListRecordingsOptions{
+		Fields: []string{"identifier", "processing_status"},
+	})
+	if err != nil {
+		t.Fatalf("ListRecordings failed: %v", err)
+	}
+
+	if got := string(result.Recordings[0].Extra["processing_status"]); got != `"processing"` {
+		t.Errorf("expected processing_status in Extra, got %q", got)
+	}
+}
+
+func TestListRecordingsFilter(t *testing.T) {
+	tests := []struct {
+		filter   string
+		expected string
+	}{
+		{filter: "", expected: "own"},
+		{filter: FilterOwn, expected: "own"},
+		// Unknown values are passed through for the API to judge
+		{filter: "shared", expected: "shared"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if got := r.URL.Query().Get("filter"); got != tt.expected {
+					t.Errorf("expected filter=%s, got %q", tt.expected, got)
+				}
+				fmt.Fprint(w, `[]`)
+			}))
+			defer server.Close()
+
+			c := NewClient(WithBaseURL(server.URL), WithAuthToken("test-token"))
+			if _, err := c.ListRecordings(context.Background(), "test-club", &ListRecordingsOptions{Filter: tt.filter}); err != nil {
+				t.Fatalf("ListRecordings failed: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package veo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestWithRateLimit(t *testing.T) {
+	var mu sync.Mutex
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests++
+		mu.Unlock()
+		w.Write([]byte(`{"identifier": "id"}`))
+	}))
+	defer server.Close()
+
+	// A burst of 2 goes through at once; the other 4 are spaced at 20/s
+	c := NewClient(WithBaseURL(server.URL), WithRateLimit(20, 2))
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.GetRecording(context.Background(), "id"); err != nil {
+				t.Errorf("GetRecording failed: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if requests != 6 {
+		t.Errorf("expected 6 requests, got %d", requests)
+	}
+	if elapsed := time.Since(start); elapsed < 180*time.Millisecond {
+		t.Errorf("expected requests to be rate limited to about 200ms, took %v", elapsed)
+	}
+}
+
+func TestRateLimiterHonorsContext(t *testing.T) {
+	l := newRateLimiter(1, 1)
+	if err := l.wait(context.Background()); err != nil {
+		t.Fatalf("expected first token immediately: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if err := l.wait(ctx); err == nil {
+		t.Error("expected wait to stop when context is done")
+	}
+
+	// The canceled reservation is returned to the bucket
+	l.mu.Lock()
+	tokens := l.tokens
+	l.mu.Unlock()
+	if tokens < -0.5 {
+		t.Errorf("expected canceled reservation to be returned, tokens = %v", tokens)
+	}
+}
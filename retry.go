@@ -0,0 +1,143 @@
+package veo
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy controls how failed requests are retried. Network errors and
+// 429, 502, 503 and 504 responses are retried with exponential backoff and
+// jitter, honoring the Retry-After header when the server sends one. If
+// Retry-After asks for longer than MaxDelay, the response is returned
+// instead of waiting.
+type RetryPolicy struct {
+	MaxAttempts        int           // Total attempts including the first; 1 disables retries
+	BaseDelay          time.Duration // Delay before the first retry, doubled for each retry after
+	MaxDelay           time.Duration // Longest wait before a retry, including Retry-After (one hour if zero)
+	RetryNonIdempotent bool          // Also retry POST and PATCH requests
+}
+
+// DefaultRetryPolicy is the retry policy used by NewClient
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    10 * time.Second,
+}
+
+// WithRetryPolicy sets the retry policy
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// sendWithRetry performs a request, retrying transient failures according
+// to the client's retry policy
+func (c *Client) sendWithRetry(ctx context.Context, method, path string, body interface{}) (*http.Response, error) {
+	policy := c.retryPolicy
+
+	for attempt := 1; ; attempt++ {
+		resp, err := c.send(ctx, method, path, body)
+
+		if attempt >= policy.MaxAttempts || !policy.allowsMethod(method) || !isRetryable(ctx, resp, err) {
+			return resp, err
+		}
+
+		delay := policy.backoff(attempt)
+		if resp != nil {
+			if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+				// Rather than stall for longer than MaxDelay, let the caller
+				// see the response
+				if retryAfter > policy.maxDelay() {
+					return resp, nil
+				}
+				delay = retryAfter
+			}
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// allowsMethod reports whether requests with method may be retried
+func (p RetryPolicy) allowsMethod(method string) bool {
+	switch method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	}
+	return p.RetryNonIdempotent
+}
+
+// maxDelay returns MaxDelay, or one hour if it isn't set
+func (p RetryPolicy) maxDelay() time.Duration {
+	if p.MaxDelay <= 0 {
+		return time.Hour
+	}
+	return p.MaxDelay
+}
+
+// backoff returns the delay before retry number attempt (starting at 1):
+// the exponential delay capped at MaxDelay, with jitter in its upper half
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	maxDelay := p.maxDelay()
+
+	delay := p.BaseDelay
+	for i := 1; i < attempt && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+	if delay <= 0 {
+		return 0
+	}
+	half := delay / 2
+	return half + rand.N(half+1)
+}
+
+// isRetryable reports whether a request failed transiently
+func isRetryable(ctx context.Context, resp *http.Response, err error) bool {
+	if err != nil {
+		// Don't retry requests the caller canceled
+		return ctx.Err() == nil
+	}
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an
+// HTTP date
+func parseRetryAfter(header string) (time.Duration, bool) {
+	if header == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(header); err == nil {
+		delay := time.Until(t)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,209 @@
+package veo
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// fastRetries retries quickly so tests don't wait on backoff
+var fastRetries = RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}
+
+// newFlakyServer fails the first failures requests with status, then succeeds
+func newFlakyServer(failures, status int, requests *int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		if *requests <= failures {
+			w.WriteHeader(status)
+			return
+		}
+		w.Write([]byte(`{"identifier": "id", "title": "Recovered"}`))
+	}))
+}
+
+func TestRetryTransientFailures(t *testing.T) {
+	var requests int
+	server := newFlakyServer(2, http.StatusBadGateway, &requests)
+	defer server.Close()
+
+	c := NewClient(WithBaseURL(server.URL), WithRetryPolicy(fastRetries))
+	details, err := c.GetRecording(context.Background(), "id")
+	if err != nil {
+		t.Fatalf("GetRecording failed: %v", err)
+	}
+	if details.Title != "Recovered" {
+		t.Errorf("expected recovered response, got %q", details.Title)
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 requests, got %d", requests)
+	}
+}
+
+func TestRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	var requests int
+	server := newFlakyServer(5, http.StatusServiceUnavailable, &requests)
+	defer server.Close()
+
+	c := NewClient(WithBaseURL(server.URL), WithRetryPolicy(fastRetries))
+	if _, err := c.GetRecording(context.Background(), "id"); err == nil {
+		t.Fatal("expected error after exhausting retries")
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 requests, got %d", requests)
+	}
+}
+
+func TestRetrySkipsNonIdempotentMethods(t *testing.T) {
+	var requests int
+	server := newFlakyServer(1, http.StatusBadGateway, &requests)
+	defer server.Close()
+
+	title := "New"
+	c := NewClient(WithBaseURL(server.URL), WithCSRFToken("csrf"), WithRetryPolicy(fastRetries))
+	if _, err := c.UpdateMatch(context.Background(), "id", &MatchUpdate{Title: &title}); err == nil {
+		t.Fatal("expected PATCH failure not to be retried")
+	}
+	if requests != 1 {
+		t.Errorf("expected 1 request, got %d", requests)
+	}
+
+	// Opting in retries PATCH too
+	requests = 0
+	policy := fastRetries
+	policy.RetryNonIdempotent = true
+	c = NewClient(WithBaseURL(server.URL), WithCSRFToken("csrf"), WithRetryPolicy(policy))
+	if _, err := c.UpdateMatch(context.Background(), "id", &MatchUpdate{Title: &title}); err != nil {
+		t.Fatalf("expected PATCH to be retried: %v", err)
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
+
+func TestRetryDoesNotRetryClientErrors(t *testing.T) {
+	var requests int
+	server := newFlakyServer(1, http.StatusNotFound, &requests)
+	defer server.Close()
+
+	c := NewClient(WithBaseURL(server.URL), WithRetryPolicy(fastRetries))
+	if _, err := c.GetRecording(context.Background(), "id"); err == nil {
+		t.Fatal("expected 404 error")
+	}
+	if requests != 1 {
+		t.Errorf("expected 1 request, got %d", requests)
+	}
+}
+
+func TestRetryHonorsRetryAfter(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"identifier": "id"}`))
+	}))
+	defer server.Close()
+
+	policy := fastRetries
+	policy.MaxDelay = 2 * time.Second
+	c := NewClient(WithBaseURL(server.URL), WithRetryPolicy(policy))
+
+	start := time.Now()
+	if _, err := c.GetRecording(context.Background(), "id"); err != nil {
+		t.Fatalf("GetRecording failed: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("expected to wait for Retry-After, took %v", elapsed)
+	}
+}
+
+func TestRetryGivesUpWhenRetryAfterExceedsMaxDelay(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	c := NewClient(WithBaseURL(server.URL), WithRetryPolicy(fastRetries))
+
+	start := time.Now()
+	_, err := c.GetRecording(context.Background(), "id")
+	if !IsRateLimited(err) {
+		t.Errorf("expected a rate limit error, got %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("expected 1 request, got %d", requests)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("expected to return without waiting, took %v", elapsed)
+	}
+}
+
+func TestRetryStopsWhenContextCanceled(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	c := NewClient(WithBaseURL(server.URL))
+	if _, err := c.GetRecording(ctx, "id"); err == nil {
+		t.Fatal("expected error when context is canceled")
+	}
+	if requests != 1 {
+		t.Errorf("expected 1 request, got %d", requests)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if d, ok := parseRetryAfter("120"); !ok || d != 2*time.Minute {
+		t.Errorf("parseRetryAfter(\"120\") = %v, %v", d, ok)
+	}
+
+	date := time.Now().Add(time.Hour).UTC().Format(http.TimeFormat)
+	if d, ok := parseRetryAfter(date); !ok || d < 59*time.Minute {
+		t.Errorf("parseRetryAfter(%q) = %v, %v", date, d, ok)
+	}
+
+	if _, ok := parseRetryAfter("soon"); ok {
+		t.Error("expected invalid Retry-After to be rejected")
+	}
+	if _, ok := parseRetryAfter(""); ok {
+		t.Error("expected empty Retry-After to be rejected")
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: 300 * time.Millisecond}
+
+	tests := []struct {
+		attempt int
+		min     time.Duration
+		max     time.Duration
+	}{
+		{attempt: 1, min: 50 * time.Millisecond, max: 100 * time.Millisecond},
+		{attempt: 2, min: 100 * time.Millisecond, max: 200 * time.Millisecond},
+		{attempt: 3, min: 150 * time.Millisecond, max: 300 * time.Millisecond}, // capped
+		{attempt: 40, min: 150 * time.Millisecond, max: 300 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		for i := 0; i < 20; i++ {
+			if d := policy.backoff(tt.attempt); d < tt.min || d > tt.max {
+				t.Errorf("backoff(%d) = %v, expected between %v and %v", tt.attempt, d, tt.min, tt.max)
+			}
+		}
+	}
+}